@@ -0,0 +1,204 @@
+// Command genprofiles regenerates langdet's bundled default language profiles from a corpus of
+// sample texts. It replaces the old default_languages.json + json.Decode bootstrap: instead of
+// shipping a data asset that gets parsed at startup, it emits Go source containing pre-built
+// langdet.Language values as literals, analogous to how golang.org/x/text/language ships its
+// generated tables.
+//
+// It writes two kinds of output:
+//
+//   - langdet/default_profiles.go: a single file aggregating every corpus language, used to
+//     populate langdet.DefaultDetector.
+//   - langdet/profiles/<code>/profile.go: one small, standalone package per language, so a caller
+//     who only needs e.g. English can `import "github.com/chrisport/go-lang-detector/langdet/profiles/en"`
+//     without pulling in the other languages' tables.
+//
+// Run via `go generate ./...` from the module root (see the //go:generate directive in
+// langdet/default_profiles.go).
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/chrisport/go-lang-detector/langdet"
+)
+
+// profileSpec describes one corpus entry, read from corpus/manifest.json.
+type profileSpec struct {
+	Name    string   `json:"name"`    // Language.Name, e.g. "english"
+	File    string   `json:"file"`    // sample text, relative to the corpus directory
+	Code    string   `json:"code"`    // package name under langdet/profiles/, e.g. "en"
+	Tag     string   `json:"tag"`     // BCP 47 tag, parsed with language.Parse
+	Scripts []string `json:"scripts"` // langdet.Script names, e.g. ["Latin"]
+}
+
+// generatedLanguage is a profileSpec plus its analyzed, alphabetically sorted rank table, ready
+// to be rendered into Go source by the templates below.
+type generatedLanguage struct {
+	profileSpec
+	Tokens []string
+	Ranks  []uint16
+}
+
+func main() {
+	corpusDir := flag.String("corpus", "cmd/genprofiles/corpus", "directory containing manifest.json and the sample texts")
+	outDir := flag.String("out", "langdet", "langdet package root to write default_profiles.go and profiles/ into")
+	flag.Parse()
+
+	languages, err := loadLanguages(*corpusDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "genprofiles:", err)
+		os.Exit(1)
+	}
+
+	if err := writeDefaultProfiles(*outDir, languages); err != nil {
+		fmt.Fprintln(os.Stderr, "genprofiles:", err)
+		os.Exit(1)
+	}
+	for _, l := range languages {
+		if err := writeProfilePackage(*outDir, l); err != nil {
+			fmt.Fprintln(os.Stderr, "genprofiles:", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// loadLanguages reads the manifest and analyzes every corpus file with langdet.Analyze, the same
+// function callers use to build a Language from their own text.
+func loadLanguages(corpusDir string) ([]generatedLanguage, error) {
+	manifestBytes, err := os.ReadFile(filepath.Join(corpusDir, "manifest.json"))
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	var specs []profileSpec
+	if err := json.Unmarshal(manifestBytes, &specs); err != nil {
+		return nil, fmt.Errorf("parsing manifest: %w", err)
+	}
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Name < specs[j].Name })
+
+	languages := make([]generatedLanguage, 0, len(specs))
+	for _, spec := range specs {
+		text, err := os.ReadFile(filepath.Join(corpusDir, spec.File))
+		if err != nil {
+			return nil, fmt.Errorf("reading corpus file for %s: %w", spec.Name, err)
+		}
+		analyzed := langdet.Analyze(string(text), spec.Name)
+
+		tokens := make([]string, 0, len(analyzed.Profile))
+		for token := range analyzed.Profile {
+			tokens = append(tokens, token)
+		}
+		sort.Strings(tokens)
+		ranks := make([]uint16, len(tokens))
+		for i, token := range tokens {
+			ranks[i] = uint16(analyzed.Profile[token])
+		}
+
+		languages = append(languages, generatedLanguage{profileSpec: spec, Tokens: tokens, Ranks: ranks})
+	}
+	return languages, nil
+}
+
+var funcMap = template.FuncMap{
+	"quote": strconv.Quote,
+	"scriptConsts": func(prefix string, scripts []string) string {
+		names := make([]string, len(scripts))
+		for i, s := range scripts {
+			names[i] = prefix + "Script" + s
+		}
+		return strings.Join(names, ", ")
+	},
+}
+
+var defaultProfilesTemplate = template.Must(template.New("default_profiles").Funcs(funcMap).Parse(`// Code generated by cmd/genprofiles from cmd/genprofiles/corpus; DO NOT EDIT.
+
+package langdet
+
+import "golang.org/x/text/language"
+
+{{range .}}
+var {{.Name}}Tokens = []string{
+{{range .Tokens}}	{{quote .}},
+{{end}}}
+
+var {{.Name}}Ranks = []uint16{
+{{range .Ranks}}	{{.}},
+{{end}}}
+{{end}}
+
+// generatedDefaultLanguages backs defaultLanguages and, through it, DefaultDetector. It is built
+// entirely from Go literals above: no file I/O, no JSON decoding, no init-time logging.
+var generatedDefaultLanguages = []LanguageComparator{
+{{range .}}	&Language{
+		Name:    {{quote .Name}},
+		Profile: BuildProfile({{.Name}}Tokens, {{.Name}}Ranks),
+		Tag:     language.MustParse({{quote .Tag}}),
+		Scripts: []Script{ {{scriptConsts "" .Scripts}} },
+	},
+{{end}}}
+`))
+
+var profilePackageTemplate = template.Must(template.New("profile_package").Funcs(funcMap).Parse(`// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/{{.File}}; DO NOT EDIT.
+
+// Package {{.Code}} provides the pre-built {{.Name}} langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package {{.Code}}
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+{{range .Tokens}}	{{quote .}},
+{{end}}}
+
+var ranks = []uint16{
+{{range .Ranks}}	{{.}},
+{{end}}}
+
+// Profile is the pre-built {{.Name}} language profile.
+var Profile = langdet.Language{
+	Name:    {{quote .Name}},
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse({{quote .Tag}}),
+	Scripts: []langdet.Script{ {{scriptConsts "langdet." .Scripts}} },
+}
+`))
+
+func writeDefaultProfiles(outDir string, languages []generatedLanguage) error {
+	var buf bytes.Buffer
+	if err := defaultProfilesTemplate.Execute(&buf, languages); err != nil {
+		return fmt.Errorf("rendering default_profiles.go: %w", err)
+	}
+	return formatAndWrite(filepath.Join(outDir, "default_profiles.go"), buf.Bytes())
+}
+
+func writeProfilePackage(outDir string, l generatedLanguage) error {
+	var buf bytes.Buffer
+	if err := profilePackageTemplate.Execute(&buf, l); err != nil {
+		return fmt.Errorf("rendering profile package for %s: %w", l.Name, err)
+	}
+	dir := filepath.Join(outDir, "profiles", l.Code)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return formatAndWrite(filepath.Join(dir, "profile.go"), buf.Bytes())
+}
+
+func formatAndWrite(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
@@ -0,0 +1,46 @@
+package langdet_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/chrisport/go-lang-detector/langdet"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDetectorConcurrentUse(t *testing.T) {
+	Convey("Subject: Test concurrent Detector use", t, func() {
+		d := langdet.NewDetector()
+		d.AddLanguageFromText("Hello I am english text, what is your language?", "english")
+
+		Convey("GetClosestLanguage/GetLanguages readers and AddLanguage* writers should run concurrently without racing", func() {
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					d.GetClosestLanguage("Hello there, what is your language?")
+					d.GetLanguages("Hello there, what is your language?")
+				}()
+			}
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					d.AddLanguageFromText("Je parles français et toi?", "french")
+				}(i)
+			}
+			wg.Wait()
+
+			So(len(d.Languages) >= 1, ShouldBeTrue)
+		})
+
+		Convey("Snapshot should keep seeing its own Languages even as the source Detector registers more", func() {
+			snap := d.Snapshot()
+			d.AddLanguageFromText("Je parles français et toi?", "french")
+
+			So(len(snap.Languages), ShouldEqual, 1)
+			So(len(d.Languages), ShouldEqual, 2)
+		})
+	})
+}
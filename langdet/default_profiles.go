@@ -0,0 +1,18956 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus; DO NOT EDIT.
+
+package langdet
+
+import "golang.org/x/text/language"
+
+var arabicTokens = []string{
+	"____\xd8",
+	"____\xd9",
+	"___\xd8",
+	"___أ",
+	"___إ",
+	"___ا",
+	"___ب",
+	"___ت",
+	"___ث",
+	"___ج",
+	"___ح",
+	"___خ",
+	"___س",
+	"___ص",
+	"___ع",
+	"___\xd9",
+	"___ف",
+	"___ق",
+	"___ك",
+	"___ل",
+	"___م",
+	"___ن",
+	"___ه",
+	"___و",
+	"___ي",
+	"__\xd8",
+	"__أ",
+	"__أ\xd9",
+	"__إ",
+	"__إ\xd9",
+	"__ا",
+	"__ا\xd9",
+	"__ب",
+	"__ب\xd8",
+	"__ب\xd9",
+	"__ت",
+	"__ت\xd8",
+	"__ت\xd9",
+	"__ث",
+	"__ث\xd8",
+	"__ث\xd9",
+	"__ج",
+	"__ج\xd8",
+	"__ج\xd9",
+	"__ح",
+	"__ح\xd8",
+	"__خ",
+	"__خ\xd9",
+	"__س",
+	"__س\xd9",
+	"__ص",
+	"__ص\xd9",
+	"__ع",
+	"__ع\xd9",
+	"__\xd9",
+	"__ف",
+	"__ف\xd9",
+	"__ق",
+	"__ق\xd8",
+	"__ك",
+	"__ك\xd8",
+	"__ل",
+	"__ل\xd8",
+	"__ل\xd9",
+	"__م",
+	"__م\xd8",
+	"__م\xd9",
+	"__ن",
+	"__ن\xd8",
+	"__ن\xd9",
+	"__ه",
+	"__ه\xd8",
+	"__و",
+	"__و\xd8",
+	"__و\xd9",
+	"__ي",
+	"__ي\xd8",
+	"__ي\xd9",
+	"_\xd8",
+	"_أ",
+	"_أ\xd9",
+	"_أن",
+	"_أو",
+	"_أي",
+	"_إ",
+	"_إ\xd9",
+	"_إل",
+	"_ا",
+	"_ا\xd9",
+	"_ال",
+	"_ب",
+	"_ب\xd8",
+	"_بت",
+	"_بث",
+	"_بح",
+	"_بص",
+	"_ب\xd9",
+	"_بم",
+	"_بن",
+	"_به",
+	"_ت",
+	"_ت\xd8",
+	"_تر",
+	"_تس",
+	"_تع",
+	"_ت\xd9",
+	"_تك",
+	"_تم",
+	"_ث",
+	"_ث\xd8",
+	"_ثا",
+	"_ث\xd9",
+	"_ثم",
+	"_ج",
+	"_ج\xd8",
+	"_جا",
+	"_جد",
+	"_ج\xd9",
+	"_جم",
+	"_ح",
+	"_ح\xd8",
+	"_حت",
+	"_خ",
+	"_خ\xd9",
+	"_خل",
+	"_س",
+	"_س\xd9",
+	"_سو",
+	"_ص",
+	"_ص\xd9",
+	"_صف",
+	"_ع",
+	"_ع\xd9",
+	"_عل",
+	"_عي",
+	"_\xd9",
+	"_ف",
+	"_ف\xd9",
+	"_في",
+	"_ق",
+	"_ق\xd8",
+	"_قص",
+	"_ك",
+	"_ك\xd8",
+	"_كب",
+	"_كت",
+	"_كش",
+	"_ل",
+	"_ل\xd8",
+	"_لغ",
+	"_ل\xd9",
+	"_لل",
+	"_م",
+	"_م\xd8",
+	"_مد",
+	"_مر",
+	"_مع",
+	"_م\xd9",
+	"_مق",
+	"_مك",
+	"_مل",
+	"_من",
+	"_ن",
+	"_ن\xd8",
+	"_نص",
+	"_ن\xd9",
+	"_نف",
+	"_ه",
+	"_ه\xd8",
+	"_هذ",
+	"_و",
+	"_و\xd8",
+	"_وا",
+	"_وت",
+	"_و\xd9",
+	"_وي",
+	"_ي",
+	"_ي\xd8",
+	"_يح",
+	"_ي\xd9",
+	"_يك",
+	"_يم",
+	"\x81",
+	"\x81\xd8",
+	"\x81ح",
+	"\x81ح\xd8",
+	"\x81حة",
+	"\x81س",
+	"\x81س\xd9",
+	"\x81سه",
+	"\x81\xd9",
+	"\x81ك",
+	"\x81ك\xd8",
+	"\x81كر",
+	"\x81ي",
+	"\x81ي_",
+	"\x81ي__",
+	"\x81ي\xd9",
+	"\x81يه",
+	"\x82",
+	"\x82\xd8",
+	"\x82ا",
+	"\x82ا\xd8",
+	"\x82ار",
+	"\x82ص",
+	"\x82ص\xd9",
+	"\x82صي",
+	"\x82ط",
+	"\x82ط\xd8",
+	"\x82طع",
+	"\x82\xd9",
+	"\x82و",
+	"\x82و\xd9",
+	"\x82ول",
+	"\x83",
+	"\x83\xd8",
+	"\x83ب",
+	"\x83ب\xd9",
+	"\x83بي",
+	"\x83ت",
+	"\x83ت\xd8",
+	"\x83تا",
+	"\x83ت\xd9",
+	"\x83تو",
+	"\x83ر",
+	"\x83ر\xd8",
+	"\x83را",
+	"\x83ش",
+	"\x83ش\xd9",
+	"\x83شف",
+	"\x83\xd9",
+	"\x83ف",
+	"\x83ف\xd9",
+	"\x83في",
+	"\x84",
+	"\x84\xd8",
+	"\x84أ",
+	"\x84أ\xd9",
+	"\x84أق",
+	"\x84أك",
+	"\x84ا",
+	"\x84ا\xd9",
+	"\x84ال",
+	"\x84ب",
+	"\x84ب\xd8",
+	"\x84بص",
+	"\x84ت",
+	"\x84ت\xd9",
+	"\x84تك",
+	"\x84ج",
+	"\x84ج\xd9",
+	"\x84جي",
+	"\x84ح",
+	"\x84ح\xd8",
+	"\x84حر",
+	"\x84س",
+	"\x84س\xd9",
+	"\x84سل",
+	"\x84ع",
+	"\x84ع\xd8",
+	"\x84عد",
+	"\x84غ",
+	"\x84غ\xd8",
+	"\x84غا",
+	"\x84غة",
+	"\x84\xd9",
+	"\x84ف",
+	"\x84ف\xd8",
+	"\x84فا",
+	"\x84ف\xd9",
+	"\x84فك",
+	"\x84ق",
+	"\x84ق\xd8",
+	"\x84قص",
+	"\x84ك",
+	"\x84ك\xd8",
+	"\x84كت",
+	"\x84كش",
+	"\x84ل",
+	"\x84ل\xd8",
+	"\x84لع",
+	"\x84لغ",
+	"\x84ل\xd9",
+	"\x84لن",
+	"\x84م",
+	"\x84م\xd9",
+	"\x84مق",
+	"\x84ن",
+	"\x84ن\xd8",
+	"\x84نص",
+	"\x84نظ",
+	"\x84نغ",
+	"\x84ي",
+	"\x84ي\xd9",
+	"\x84يو",
+	"\x85",
+	"\x85\xd8",
+	"\x85د",
+	"\x85د\xd9",
+	"\x85دم",
+	"\x85ر",
+	"\x85ر\xd8",
+	"\x85رب",
+	"\x85ع",
+	"\x85ع_",
+	"\x85ع__",
+	"\x85ع\xd8",
+	"\x85عر",
+	"\x85ع\xd9",
+	"\x85عق",
+	"\x85عي",
+	"\x85\xd9",
+	"\x85ق",
+	"\x85ق\xd8",
+	"\x85قا",
+	"\x85قط",
+	"\x85ك",
+	"\x85ك\xd8",
+	"\x85كت",
+	"\x85ك\xd9",
+	"\x85كن",
+	"\x85ل",
+	"\x85ل\xd8",
+	"\x85لة",
+	"\x85ل\xd9",
+	"\x85لف",
+	"\x85ن",
+	"\x85ن_",
+	"\x85ن__",
+	"\x85ي",
+	"\x85ي\xd9",
+	"\x85يل",
+	"\x86",
+	"\x86\xd8",
+	"\x86ا",
+	"\x86ا\xd8",
+	"\x86اء",
+	"\x86ص",
+	"\x86ص_",
+	"\x86ص__",
+	"\x86ط",
+	"\x86ط\xd8",
+	"\x86طب",
+	"\x86ظ",
+	"\x86ظ\xd8",
+	"\x86ظا",
+	"\x86ظ\xd9",
+	"\x86ظم",
+	"\x86غ",
+	"\x86غ\xd8",
+	"\x86غر",
+	"\x86\xd9",
+	"\x86ف",
+	"\x86ف\xd8",
+	"\x86فس",
+	"\x86م",
+	"\x86م\xd8",
+	"\x86ما",
+	"\x87",
+	"\x87\xd8",
+	"\x87ذ",
+	"\x87ذ\xd9",
+	"\x87ذه",
+	"\x88",
+	"\x88\xd8",
+	"\x88ا",
+	"\x88ا\xd8",
+	"\x88اء",
+	"\x88اح",
+	"\x88ا\xd9",
+	"\x88ال",
+	"\x88ت",
+	"\x88ت\xd9",
+	"\x88تن",
+	"\x88\xd9",
+	"\x88ي",
+	"\x88ي\xd8",
+	"\x88يب",
+	"\x88ي\xd9",
+	"\x88يق",
+	"\x8a",
+	"\x8a\xd8",
+	"\x8aح",
+	"\x8aح\xd9",
+	"\x8aحل",
+	"\x8aر",
+	"\x8aر\xd9",
+	"\x8aرً",
+	"\x8a\xd9",
+	"\x8aق",
+	"\x8aق\xd8",
+	"\x8aقا",
+	"\x8aك",
+	"\x8aك\xd9",
+	"\x8aكف",
+	"\x8aم",
+	"\x8aم\xd9",
+	"\x8aمك",
+	"\x8aن",
+	"\x8aن\xd8",
+	"\x8aنة",
+	"\x8aو",
+	"\x8aو\xd9",
+	"\x8aوم",
+	"\xa3",
+	"\xa3\xd9",
+	"\xa3ك",
+	"\xa3ك\xd8",
+	"\xa3كث",
+	"\xa3ن",
+	"\xa3ن\xd8",
+	"\xa3نظ",
+	"\xa3ن\xd9",
+	"\xa3نم",
+	"\xa3و",
+	"\xa3و_",
+	"\xa3و__",
+	"\xa3ي",
+	"\xa3ي_",
+	"\xa3ي__",
+	"\xa5",
+	"\xa5\xd9",
+	"\xa5ل",
+	"\xa5ل\xd9",
+	"\xa5لى",
+	"\xa7",
+	"\xa7\xd8",
+	"\xa7ب",
+	"\xa7ب\xd8",
+	"\xa7بت",
+	"\xa7ح",
+	"\xa7ح\xd8",
+	"\xa7حد",
+	"\xa7ر",
+	"\xa7ر\xd9",
+	"\xa7رن",
+	"\xa7\xd9",
+	"\xa7ل",
+	"\xa7ل\xd8",
+	"\xa7لأ",
+	"\xa7لب",
+	"\xa7لت",
+	"\xa7لج",
+	"\xa7لح",
+	"\xa7ل\xd9",
+	"\xa7لف",
+	"\xa7لق",
+	"\xa7لك",
+	"\xa7لل",
+	"\xa7لم",
+	"\xa7لن",
+	"\xa7لي",
+	"\xa8",
+	"\xa8\xd8",
+	"\xa8ت",
+	"\xa8ت\xd8",
+	"\xa8تر",
+	"\xa8ث",
+	"\xa8ث\xd9",
+	"\xa8ثق",
+	"\xa8ح",
+	"\xa8ح\xd8",
+	"\xa8حث",
+	"\xa8ص",
+	"\xa8ص\xd9",
+	"\xa8صم",
+	"\xa8\xd9",
+	"\xa8م",
+	"\xa8م\xd8",
+	"\xa8ما",
+	"\xa8ن",
+	"\xa8ن\xd8",
+	"\xa8نا",
+	"\xa8ه",
+	"\xa8ه\xd8",
+	"\xa8هذ",
+	"\xa8ي",
+	"\xa8ي\xd8",
+	"\xa8ير",
+	"\xaa",
+	"\xaa\xd8",
+	"\xaaا",
+	"\xaaا\xd8",
+	"\xaaاب",
+	"\xaaر",
+	"\xaaر\xd8",
+	"\xaaرت",
+	"\xaaرد",
+	"\xaaس",
+	"\xaaس\xd9",
+	"\xaaسل",
+	"\xaaسم",
+	"\xaaع",
+	"\xaaع\xd8",
+	"\xaaعت",
+	"\xaa\xd9",
+	"\xaaك",
+	"\xaaك\xd8",
+	"\xaaكر",
+	"\xaaم",
+	"\xaaم\xd9",
+	"\xaaمي",
+	"\xaaن",
+	"\xaaن\xd8",
+	"\xaaنط",
+	"\xaaو",
+	"\xaaو\xd8",
+	"\xaaوب",
+	"\xab",
+	"\xab\xd8",
+	"\xabا",
+	"\xabا\xd8",
+	"\xabاب",
+	"\xab\xd9",
+	"\xabق",
+	"\xabق\xd8",
+	"\xabقة",
+	"\xabم",
+	"\xabم_",
+	"\xabم__",
+	"\xac",
+	"\xac\xd8",
+	"\xacا",
+	"\xacا\xd8",
+	"\xacاء",
+	"\xacد",
+	"\xacد\xd9",
+	"\xacدي",
+	"\xacدً",
+	"\xac\xd9",
+	"\xacم",
+	"\xacم\xd9",
+	"\xacمل",
+	"\xacي",
+	"\xacي\xd8",
+	"\xacيد",
+	"\xad",
+	"\xad\xd8",
+	"\xadت",
+	"\xadت\xd9",
+	"\xadتى",
+	"\xadر",
+	"\xadر\xd9",
+	"\xadرو",
+	"\xad\xd9",
+	"\xadل",
+	"\xadل\xd9",
+	"\xadلل",
+	"\xae",
+	"\xae\xd9",
+	"\xaeل",
+	"\xaeل\xd8",
+	"\xaeلا",
+	"\xaf",
+	"\xaf\xd9",
+	"\xafم",
+	"\xafم\xd8",
+	"\xafمج",
+	"\xafي",
+	"\xafي\xd8",
+	"\xafيد",
+	"\xafً",
+	"\xafً\xd8",
+	"\xafًا",
+	"\xb1",
+	"\xb1\xd8",
+	"\xb1ا",
+	"\xb1ا\xd8",
+	"\xb1ار",
+	"\xb1ب",
+	"\xb1ب\xd8",
+	"\xb1بع",
+	"\xb1ت",
+	"\xb1ت\xd9",
+	"\xb1تي",
+	"\xb1\xd9",
+	"\xb1و",
+	"\xb1و\xd9",
+	"\xb1وف",
+	"\xb3",
+	"\xb3\xd9",
+	"\xb3ل",
+	"\xb3ل\xd8",
+	"\xb3لس",
+	"\xb3م",
+	"\xb3م\xd9",
+	"\xb3مى",
+	"\xb3و",
+	"\xb3و\xd8",
+	"\xb3وا",
+	"\xb5",
+	"\xb5\xd9",
+	"\xb5ف",
+	"\xb5ف\xd8",
+	"\xb5فح",
+	"\xb5م",
+	"\xb5م\xd8",
+	"\xb5مة",
+	"\xb5ي",
+	"\xb5ي\xd8",
+	"\xb5ير",
+	"\xb7",
+	"\xb7\xd8",
+	"\xb7ع",
+	"\xb7ع\xd9",
+	"\xb7عً",
+	"\xb9",
+	"\xb9\xd8",
+	"\xb9ت",
+	"\xb9ت\xd9",
+	"\xb9تم",
+	"\xb9د",
+	"\xb9د\xd9",
+	"\xb9دي",
+	"\xb9ر",
+	"\xb9ر\xd9",
+	"\xb9رو",
+	"\xb9\xd9",
+	"\xb9ق",
+	"\xb9ق\xd9",
+	"\xb9قو",
+	"\xb9ل",
+	"\xb9ل\xd9",
+	"\xb9لى",
+	"\xb9ي",
+	"\xb9ي\xd9",
+	"\xb9ين",
+	"\xba",
+	"\xba\xd8",
+	"\xbaر",
+	"\xbaر\xd8",
+	"\xbaرا",
+	"\xd8",
+	"أ",
+	"أ\xd9",
+	"أق",
+	"أق\xd9",
+	"أك",
+	"أك\xd8",
+	"أن",
+	"أن\xd8",
+	"أن\xd9",
+	"أو",
+	"أو_",
+	"أي",
+	"أي_",
+	"إ",
+	"إ\xd9",
+	"إل",
+	"إل\xd9",
+	"ا",
+	"ا\xd8",
+	"اء",
+	"اء_",
+	"اب",
+	"اب\xd8",
+	"اح",
+	"اح\xd8",
+	"ار",
+	"ار\xd9",
+	"ا\xd9",
+	"ال",
+	"ال\xd8",
+	"ال\xd9",
+	"ب",
+	"ب\xd8",
+	"بت",
+	"بت\xd8",
+	"بث",
+	"بث\xd9",
+	"بح",
+	"بح\xd8",
+	"بص",
+	"بص\xd9",
+	"ب\xd9",
+	"بم",
+	"بم\xd8",
+	"بن",
+	"بن\xd8",
+	"به",
+	"به\xd8",
+	"بي",
+	"بي\xd8",
+	"ت",
+	"ت\xd8",
+	"تا",
+	"تا\xd8",
+	"تر",
+	"تر\xd8",
+	"تس",
+	"تس\xd9",
+	"تع",
+	"تع\xd8",
+	"ت\xd9",
+	"تك",
+	"تك\xd8",
+	"تم",
+	"تم\xd8",
+	"تم\xd9",
+	"تن",
+	"تن\xd8",
+	"تو",
+	"تو\xd8",
+	"تى",
+	"تى_",
+	"تي",
+	"تي\xd8",
+	"ث",
+	"ث\xd8",
+	"ثا",
+	"ثا\xd8",
+	"ث\xd9",
+	"ثق",
+	"ثق\xd8",
+	"ثم",
+	"ثم_",
+	"ج",
+	"ج\xd8",
+	"جا",
+	"جا\xd8",
+	"جد",
+	"جد\xd9",
+	"ج\xd9",
+	"جم",
+	"جم\xd9",
+	"جي",
+	"جي\xd8",
+	"ح",
+	"ح\xd8",
+	"حت",
+	"حت\xd9",
+	"حث",
+	"حث_",
+	"حد",
+	"حد\xd8",
+	"حر",
+	"حر\xd9",
+	"ح\xd9",
+	"حل",
+	"حل\xd9",
+	"خ",
+	"خ\xd9",
+	"خل",
+	"خل\xd8",
+	"د",
+	"د\xd9",
+	"دم",
+	"دم\xd8",
+	"دي",
+	"دي\xd8",
+	"دً",
+	"دً\xd8",
+	"ذ",
+	"ذ\xd9",
+	"ذه",
+	"ذه_",
+	"ر",
+	"ر\xd8",
+	"را",
+	"را\xd8",
+	"را\xd9",
+	"رب",
+	"رب\xd8",
+	"رت",
+	"رت\xd9",
+	"رد",
+	"رد\xd8",
+	"ر\xd9",
+	"رو",
+	"رو\xd9",
+	"س",
+	"س\xd9",
+	"سل",
+	"سل\xd8",
+	"سم",
+	"سم\xd9",
+	"سه",
+	"سه\xd8",
+	"سو",
+	"سو\xd8",
+	"ش",
+	"ش\xd9",
+	"شف",
+	"شف_",
+	"ص",
+	"ص\xd9",
+	"صف",
+	"صف\xd8",
+	"صم",
+	"صم\xd8",
+	"صي",
+	"صي\xd8",
+	"ط",
+	"ط\xd8",
+	"طع",
+	"طع\xd9",
+	"ظ",
+	"ظ\xd9",
+	"ظم",
+	"ظم\xd8",
+	"ع",
+	"ع\xd8",
+	"عت",
+	"عت\xd9",
+	"عد",
+	"عد\xd9",
+	"عر",
+	"عر\xd9",
+	"ع\xd9",
+	"عق",
+	"عق\xd9",
+	"عل",
+	"عل\xd9",
+	"عي",
+	"عي\xd9",
+	"غ",
+	"غ\xd8",
+	"غة",
+	"غة_",
+	"غر",
+	"غر\xd8",
+	"\xd9",
+	"ف",
+	"ف\xd8",
+	"فا",
+	"فا\xd8",
+	"فح",
+	"فح\xd8",
+	"فس",
+	"فس\xd9",
+	"ف\xd9",
+	"فك",
+	"فك\xd8",
+	"في",
+	"في_",
+	"في\xd9",
+	"ق",
+	"ق\xd8",
+	"قا",
+	"قا\xd8",
+	"قص",
+	"قص\xd9",
+	"قط",
+	"قط\xd8",
+	"ق\xd9",
+	"قو",
+	"قو\xd9",
+	"ك",
+	"ك\xd8",
+	"كب",
+	"كب\xd9",
+	"كت",
+	"كت\xd8",
+	"كت\xd9",
+	"كر",
+	"كر\xd8",
+	"كش",
+	"كش\xd9",
+	"ك\xd9",
+	"كف",
+	"كف\xd9",
+	"ل",
+	"ل\xd8",
+	"لأ",
+	"لأ\xd9",
+	"لا",
+	"لا\xd9",
+	"لب",
+	"لب\xd8",
+	"لت",
+	"لت\xd9",
+	"لج",
+	"لج\xd9",
+	"لح",
+	"لح\xd8",
+	"لس",
+	"لس\xd9",
+	"لع",
+	"لع\xd8",
+	"لغ",
+	"لغ\xd8",
+	"ل\xd9",
+	"لف",
+	"لف\xd8",
+	"لف\xd9",
+	"لق",
+	"لق\xd8",
+	"لك",
+	"لك\xd8",
+	"لل",
+	"لل\xd8",
+	"لل\xd9",
+	"لم",
+	"لم\xd9",
+	"لن",
+	"لن\xd8",
+	"لى",
+	"لى_",
+	"لي",
+	"لي\xd9",
+	"م",
+	"م\xd8",
+	"ما",
+	"ما_",
+	"ما\xd8",
+	"مج",
+	"مج\xd8",
+	"مد",
+	"مد\xd9",
+	"مر",
+	"مر\xd8",
+	"مع",
+	"مع_",
+	"مع\xd8",
+	"مع\xd9",
+	"م\xd9",
+	"مق",
+	"مق\xd8",
+	"مك",
+	"مك\xd8",
+	"مك\xd9",
+	"مل",
+	"مل\xd8",
+	"مل\xd9",
+	"من",
+	"من_",
+	"مي",
+	"مي\xd9",
+	"ن",
+	"ن\xd8",
+	"نا",
+	"نا\xd8",
+	"نص",
+	"نص_",
+	"نط",
+	"نط\xd8",
+	"نظ",
+	"نظ\xd8",
+	"نظ\xd9",
+	"نغ",
+	"نغ\xd8",
+	"ن\xd9",
+	"نف",
+	"نف\xd8",
+	"نم",
+	"نم\xd8",
+	"ه",
+	"ه\xd8",
+	"هذ",
+	"هذ\xd9",
+	"و",
+	"و\xd8",
+	"وا",
+	"وا\xd8",
+	"وا\xd9",
+	"وت",
+	"وت\xd9",
+	"و\xd9",
+	"وم",
+	"وم\xd9",
+	"وي",
+	"وي\xd8",
+	"وي\xd9",
+	"ي",
+	"ي\xd8",
+	"يب",
+	"يب_",
+	"يح",
+	"يح\xd9",
+	"ير",
+	"ير\xd8",
+	"ير\xd9",
+	"ي\xd9",
+	"يق",
+	"يق\xd8",
+	"يك",
+	"يك\xd9",
+	"يم",
+	"يم\xd9",
+	"ين",
+	"ين\xd8",
+	"يه",
+	"يه_",
+	"يو",
+	"يو\xd9",
+}
+
+var arabicRanks = []uint16{
+	6,
+	10,
+	5,
+	121,
+	397,
+	27,
+	78,
+	92,
+	396,
+	157,
+	985,
+	984,
+	983,
+	982,
+	395,
+	9,
+	156,
+	394,
+	220,
+	155,
+	30,
+	393,
+	981,
+	120,
+	219,
+	4,
+	119,
+	118,
+	392,
+	391,
+	26,
+	25,
+	77,
+	154,
+	218,
+	91,
+	153,
+	390,
+	389,
+	980,
+	979,
+	152,
+	217,
+	978,
+	977,
+	976,
+	975,
+	974,
+	973,
+	972,
+	971,
+	970,
+	388,
+	387,
+	8,
+	151,
+	150,
+	386,
+	385,
+	216,
+	215,
+	149,
+	969,
+	214,
+	29,
+	90,
+	41,
+	384,
+	968,
+	967,
+	966,
+	965,
+	117,
+	213,
+	383,
+	212,
+	964,
+	382,
+	3,
+	116,
+	115,
+	381,
+	380,
+	963,
+	379,
+	378,
+	377,
+	24,
+	23,
+	22,
+	76,
+	148,
+	962,
+	961,
+	960,
+	959,
+	211,
+	958,
+	957,
+	956,
+	89,
+	147,
+	955,
+	376,
+	954,
+	375,
+	953,
+	952,
+	374,
+	951,
+	950,
+	949,
+	948,
+	146,
+	210,
+	947,
+	373,
+	946,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	933,
+	372,
+	371,
+	932,
+	931,
+	7,
+	145,
+	144,
+	143,
+	370,
+	369,
+	368,
+	209,
+	208,
+	930,
+	929,
+	928,
+	142,
+	927,
+	926,
+	207,
+	206,
+	28,
+	88,
+	925,
+	924,
+	141,
+	40,
+	367,
+	923,
+	922,
+	60,
+	366,
+	921,
+	920,
+	919,
+	918,
+	917,
+	916,
+	915,
+	114,
+	205,
+	365,
+	914,
+	364,
+	363,
+	204,
+	913,
+	912,
+	362,
+	911,
+	910,
+	75,
+	361,
+	909,
+	908,
+	907,
+	906,
+	905,
+	904,
+	113,
+	903,
+	902,
+	901,
+	140,
+	203,
+	202,
+	900,
+	899,
+	74,
+	87,
+	360,
+	359,
+	358,
+	201,
+	200,
+	199,
+	898,
+	897,
+	896,
+	895,
+	894,
+	893,
+	892,
+	73,
+	86,
+	891,
+	890,
+	889,
+	198,
+	357,
+	356,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	877,
+	12,
+	36,
+	355,
+	354,
+	876,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	353,
+	352,
+	351,
+	862,
+	861,
+	860,
+	859,
+	858,
+	857,
+	197,
+	196,
+	856,
+	350,
+	31,
+	349,
+	855,
+	854,
+	853,
+	852,
+	851,
+	850,
+	849,
+	348,
+	347,
+	848,
+	847,
+	112,
+	139,
+	846,
+	195,
+	845,
+	844,
+	843,
+	842,
+	841,
+	111,
+	110,
+	346,
+	840,
+	345,
+	839,
+	838,
+	837,
+	16,
+	85,
+	836,
+	835,
+	834,
+	833,
+	832,
+	831,
+	138,
+	830,
+	829,
+	828,
+	827,
+	344,
+	826,
+	825,
+	33,
+	194,
+	193,
+	343,
+	824,
+	342,
+	823,
+	822,
+	821,
+	820,
+	341,
+	819,
+	818,
+	817,
+	816,
+	59,
+	58,
+	57,
+	815,
+	814,
+	813,
+	48,
+	72,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	340,
+	803,
+	802,
+	801,
+	800,
+	339,
+	338,
+	337,
+	336,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	335,
+	334,
+	333,
+	332,
+	331,
+	84,
+	137,
+	192,
+	330,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	329,
+	328,
+	786,
+	785,
+	784,
+	783,
+	71,
+	327,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	109,
+	776,
+	775,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	83,
+	82,
+	761,
+	760,
+	759,
+	326,
+	758,
+	757,
+	756,
+	755,
+	325,
+	324,
+	323,
+	754,
+	753,
+	752,
+	322,
+	321,
+	320,
+	319,
+	318,
+	15,
+	191,
+	751,
+	750,
+	749,
+	748,
+	747,
+	746,
+	745,
+	744,
+	743,
+	21,
+	20,
+	56,
+	317,
+	742,
+	316,
+	741,
+	315,
+	39,
+	740,
+	739,
+	314,
+	313,
+	738,
+	136,
+	737,
+	47,
+	108,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	728,
+	312,
+	311,
+	310,
+	135,
+	727,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	717,
+	716,
+	42,
+	81,
+	715,
+	714,
+	713,
+	309,
+	308,
+	712,
+	711,
+	307,
+	306,
+	710,
+	709,
+	708,
+	707,
+	706,
+	107,
+	305,
+	304,
+	303,
+	705,
+	704,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	190,
+	696,
+	695,
+	694,
+	693,
+	302,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	106,
+	189,
+	686,
+	685,
+	684,
+	301,
+	300,
+	683,
+	682,
+	299,
+	681,
+	680,
+	679,
+	678,
+	677,
+	676,
+	134,
+	188,
+	675,
+	674,
+	673,
+	298,
+	297,
+	296,
+	672,
+	671,
+	670,
+	669,
+	668,
+	667,
+	666,
+	665,
+	664,
+	187,
+	186,
+	663,
+	662,
+	661,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	133,
+	185,
+	654,
+	653,
+	652,
+	651,
+	650,
+	649,
+	648,
+	647,
+	646,
+	645,
+	644,
+	643,
+	642,
+	184,
+	183,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	635,
+	634,
+	633,
+	132,
+	131,
+	632,
+	631,
+	630,
+	629,
+	628,
+	627,
+	295,
+	294,
+	293,
+	626,
+	625,
+	624,
+	623,
+	622,
+	70,
+	182,
+	621,
+	620,
+	619,
+	618,
+	617,
+	616,
+	615,
+	614,
+	613,
+	130,
+	612,
+	611,
+	610,
+	609,
+	608,
+	607,
+	292,
+	291,
+	290,
+	289,
+	288,
+	287,
+	286,
+	285,
+	2,
+	69,
+	68,
+	606,
+	605,
+	604,
+	603,
+	284,
+	602,
+	601,
+	283,
+	282,
+	600,
+	599,
+	281,
+	280,
+	279,
+	278,
+	14,
+	105,
+	598,
+	597,
+	596,
+	595,
+	594,
+	593,
+	277,
+	276,
+	19,
+	18,
+	55,
+	38,
+	46,
+	104,
+	592,
+	591,
+	590,
+	589,
+	588,
+	587,
+	275,
+	274,
+	129,
+	586,
+	585,
+	584,
+	583,
+	582,
+	581,
+	580,
+	579,
+	35,
+	67,
+	273,
+	272,
+	271,
+	270,
+	269,
+	268,
+	578,
+	577,
+	54,
+	267,
+	266,
+	265,
+	576,
+	575,
+	574,
+	573,
+	572,
+	571,
+	570,
+	569,
+	568,
+	567,
+	181,
+	566,
+	565,
+	564,
+	264,
+	563,
+	562,
+	561,
+	560,
+	103,
+	180,
+	559,
+	558,
+	263,
+	262,
+	261,
+	557,
+	556,
+	555,
+	554,
+	80,
+	102,
+	553,
+	552,
+	551,
+	550,
+	549,
+	548,
+	260,
+	259,
+	547,
+	546,
+	545,
+	544,
+	543,
+	542,
+	541,
+	179,
+	178,
+	540,
+	539,
+	538,
+	537,
+	536,
+	535,
+	534,
+	533,
+	532,
+	531,
+	66,
+	79,
+	177,
+	530,
+	258,
+	529,
+	528,
+	527,
+	526,
+	525,
+	524,
+	523,
+	522,
+	521,
+	101,
+	100,
+	257,
+	256,
+	520,
+	519,
+	518,
+	517,
+	516,
+	515,
+	514,
+	513,
+	512,
+	511,
+	99,
+	98,
+	510,
+	509,
+	508,
+	507,
+	176,
+	175,
+	506,
+	505,
+	504,
+	503,
+	502,
+	501,
+	500,
+	499,
+	65,
+	174,
+	498,
+	497,
+	496,
+	495,
+	494,
+	493,
+	128,
+	492,
+	491,
+	490,
+	489,
+	255,
+	254,
+	173,
+	172,
+	488,
+	487,
+	253,
+	252,
+	1,
+	53,
+	171,
+	486,
+	485,
+	484,
+	483,
+	482,
+	481,
+	97,
+	480,
+	479,
+	127,
+	170,
+	478,
+	52,
+	64,
+	169,
+	168,
+	167,
+	166,
+	477,
+	476,
+	475,
+	474,
+	473,
+	45,
+	51,
+	472,
+	471,
+	165,
+	251,
+	470,
+	250,
+	249,
+	248,
+	247,
+	469,
+	468,
+	467,
+	11,
+	34,
+	246,
+	245,
+	466,
+	465,
+	464,
+	463,
+	244,
+	243,
+	462,
+	461,
+	242,
+	241,
+	460,
+	459,
+	458,
+	457,
+	126,
+	125,
+	17,
+	240,
+	456,
+	455,
+	454,
+	453,
+	239,
+	238,
+	96,
+	124,
+	452,
+	451,
+	450,
+	95,
+	94,
+	164,
+	163,
+	449,
+	448,
+	13,
+	44,
+	237,
+	447,
+	446,
+	445,
+	444,
+	443,
+	442,
+	441,
+	440,
+	123,
+	439,
+	438,
+	236,
+	32,
+	162,
+	161,
+	235,
+	437,
+	436,
+	234,
+	435,
+	434,
+	50,
+	49,
+	433,
+	432,
+	43,
+	63,
+	431,
+	430,
+	429,
+	428,
+	427,
+	426,
+	233,
+	425,
+	424,
+	232,
+	231,
+	230,
+	423,
+	422,
+	421,
+	420,
+	229,
+	228,
+	227,
+	226,
+	62,
+	122,
+	160,
+	225,
+	419,
+	418,
+	417,
+	159,
+	416,
+	415,
+	224,
+	414,
+	413,
+	37,
+	93,
+	412,
+	411,
+	410,
+	409,
+	158,
+	223,
+	408,
+	61,
+	407,
+	406,
+	405,
+	404,
+	403,
+	402,
+	222,
+	221,
+	401,
+	400,
+	399,
+	398,
+}
+
+var englishTokens = []string{
+	"B",
+	"By",
+	"By_",
+	"By__",
+	"By___",
+	"G",
+	"Go",
+	"Goo",
+	"Good",
+	"Good_",
+	"L",
+	"La",
+	"Lan",
+	"Lang",
+	"Langu",
+	"T",
+	"Th",
+	"The",
+	"The_",
+	"The__",
+	"_B",
+	"_By",
+	"_By_",
+	"_By__",
+	"_G",
+	"_Go",
+	"_Goo",
+	"_Good",
+	"_L",
+	"_La",
+	"_Lan",
+	"_Lang",
+	"_T",
+	"_Th",
+	"_The",
+	"_The_",
+	"__B",
+	"__By",
+	"__By_",
+	"__G",
+	"__Go",
+	"__Goo",
+	"__L",
+	"__La",
+	"__Lan",
+	"__T",
+	"__Th",
+	"__The",
+	"___B",
+	"___By",
+	"___G",
+	"___Go",
+	"___L",
+	"___La",
+	"___T",
+	"___Th",
+	"____B",
+	"____G",
+	"____L",
+	"____T",
+	"____a",
+	"____b",
+	"____c",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____i",
+	"____k",
+	"____l",
+	"____m",
+	"____n",
+	"____o",
+	"____p",
+	"____r",
+	"____s",
+	"____t",
+	"____v",
+	"____w",
+	"___a",
+	"___a_",
+	"___ag",
+	"___an",
+	"___b",
+	"___bo",
+	"___bu",
+	"___c",
+	"___ca",
+	"___co",
+	"___d",
+	"___de",
+	"___e",
+	"___en",
+	"___ev",
+	"___f",
+	"___fi",
+	"___fo",
+	"___fr",
+	"___g",
+	"___gi",
+	"___gr",
+	"___i",
+	"___id",
+	"___in",
+	"___it",
+	"___k",
+	"___kn",
+	"___l",
+	"___la",
+	"___le",
+	"___m",
+	"___ma",
+	"___mo",
+	"___n",
+	"___n_",
+	"___ne",
+	"___o",
+	"___of",
+	"___on",
+	"___or",
+	"___p",
+	"___pa",
+	"___pr",
+	"___r",
+	"___ra",
+	"___re",
+	"___s",
+	"___sa",
+	"___se",
+	"___sh",
+	"___si",
+	"___sn",
+	"___st",
+	"___t",
+	"___te",
+	"___th",
+	"___to",
+	"___ty",
+	"___v",
+	"___ve",
+	"___w",
+	"___we",
+	"___wh",
+	"___wi",
+	"___wo",
+	"___wr",
+	"__a",
+	"__a_",
+	"__a__",
+	"__ag",
+	"__aga",
+	"__an",
+	"__ana",
+	"__and",
+	"__any",
+	"__b",
+	"__bo",
+	"__boo",
+	"__box",
+	"__bu",
+	"__bui",
+	"__c",
+	"__ca",
+	"__cal",
+	"__can",
+	"__co",
+	"__com",
+	"__con",
+	"__d",
+	"__de",
+	"__det",
+	"__e",
+	"__en",
+	"__eno",
+	"__ev",
+	"__eve",
+	"__f",
+	"__fi",
+	"__fin",
+	"__fo",
+	"__for",
+	"__fr",
+	"__fre",
+	"__fro",
+	"__g",
+	"__gi",
+	"__giv",
+	"__gr",
+	"__gra",
+	"__i",
+	"__id",
+	"__ide",
+	"__in",
+	"__in_",
+	"__int",
+	"__it",
+	"__its",
+	"__k",
+	"__kn",
+	"__kno",
+	"__l",
+	"__la",
+	"__lan",
+	"__lar",
+	"__lat",
+	"__le",
+	"__lea",
+	"__let",
+	"__m",
+	"__ma",
+	"__man",
+	"__mat",
+	"__mo",
+	"__mos",
+	"__n",
+	"__n_",
+	"__n__",
+	"__ne",
+	"__new",
+	"__o",
+	"__of",
+	"__of_",
+	"__on",
+	"__on_",
+	"__or",
+	"__or_",
+	"__p",
+	"__pa",
+	"__pag",
+	"__pas",
+	"__pat",
+	"__pr",
+	"__pro",
+	"__r",
+	"__ra",
+	"__ran",
+	"__re",
+	"__rea",
+	"__rel",
+	"__rep",
+	"__s",
+	"__sa",
+	"__sam",
+	"__se",
+	"__sea",
+	"__sen",
+	"__seq",
+	"__sh",
+	"__sho",
+	"__si",
+	"__sin",
+	"__sn",
+	"__sni",
+	"__st",
+	"__sta",
+	"__t",
+	"__te",
+	"__ten",
+	"__tex",
+	"__th",
+	"__tha",
+	"__the",
+	"__to",
+	"__to_",
+	"__ty",
+	"__typ",
+	"__v",
+	"__ve",
+	"__ver",
+	"__w",
+	"__we",
+	"__web",
+	"__wh",
+	"__whe",
+	"__whi",
+	"__wi",
+	"__wit",
+	"__wo",
+	"__wor",
+	"__wr",
+	"__wri",
+	"_a",
+	"_a_",
+	"_a__",
+	"_a___",
+	"_ag",
+	"_aga",
+	"_agai",
+	"_an",
+	"_ana",
+	"_anal",
+	"_and",
+	"_and_",
+	"_any",
+	"_any_",
+	"_b",
+	"_bo",
+	"_boo",
+	"_book",
+	"_box",
+	"_box_",
+	"_bu",
+	"_bui",
+	"_buil",
+	"_c",
+	"_ca",
+	"_cal",
+	"_call",
+	"_can",
+	"_can_",
+	"_co",
+	"_com",
+	"_come",
+	"_comm",
+	"_comp",
+	"_con",
+	"_conf",
+	"_d",
+	"_de",
+	"_det",
+	"_dete",
+	"_e",
+	"_en",
+	"_eno",
+	"_enou",
+	"_ev",
+	"_eve",
+	"_even",
+	"_ever",
+	"_f",
+	"_fi",
+	"_fin",
+	"_fing",
+	"_fo",
+	"_for",
+	"_for_",
+	"_fr",
+	"_fre",
+	"_freq",
+	"_fro",
+	"_from",
+	"_g",
+	"_gi",
+	"_giv",
+	"_give",
+	"_gr",
+	"_gra",
+	"_gram",
+	"_i",
+	"_id",
+	"_ide",
+	"_idea",
+	"_in",
+	"_in_",
+	"_in__",
+	"_int",
+	"_into",
+	"_it",
+	"_its",
+	"_its_",
+	"_k",
+	"_kn",
+	"_kno",
+	"_know",
+	"_l",
+	"_la",
+	"_lan",
+	"_lang",
+	"_lar",
+	"_larg",
+	"_lat",
+	"_late",
+	"_le",
+	"_lea",
+	"_leas",
+	"_let",
+	"_lett",
+	"_m",
+	"_ma",
+	"_man",
+	"_many",
+	"_mat",
+	"_matc",
+	"_mo",
+	"_mos",
+	"_most",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_ne",
+	"_new",
+	"_new_",
+	"_o",
+	"_of",
+	"_of_",
+	"_of__",
+	"_on",
+	"_on_",
+	"_on__",
+	"_or",
+	"_or_",
+	"_or__",
+	"_p",
+	"_pa",
+	"_pag",
+	"_page",
+	"_pas",
+	"_pass",
+	"_pat",
+	"_patt",
+	"_pr",
+	"_pro",
+	"_prof",
+	"_r",
+	"_ra",
+	"_ran",
+	"_rank",
+	"_re",
+	"_rea",
+	"_reas",
+	"_rel",
+	"_rely",
+	"_rep",
+	"_repe",
+	"_s",
+	"_sa",
+	"_sam",
+	"_same",
+	"_samp",
+	"_se",
+	"_sea",
+	"_sear",
+	"_sen",
+	"_sent",
+	"_seq",
+	"_sequ",
+	"_sh",
+	"_sho",
+	"_shor",
+	"_si",
+	"_sin",
+	"_sing",
+	"_sn",
+	"_sni",
+	"_snip",
+	"_st",
+	"_sta",
+	"_stab",
+	"_t",
+	"_te",
+	"_ten",
+	"_tend",
+	"_tex",
+	"_text",
+	"_th",
+	"_tha",
+	"_that",
+	"_the",
+	"_the_",
+	"_thes",
+	"_to",
+	"_to_",
+	"_to__",
+	"_ty",
+	"_typ",
+	"_type",
+	"_v",
+	"_ve",
+	"_ver",
+	"_very",
+	"_w",
+	"_we",
+	"_web",
+	"_web_",
+	"_wh",
+	"_whe",
+	"_whet",
+	"_whi",
+	"_whic",
+	"_wi",
+	"_wit",
+	"_with",
+	"_wo",
+	"_wor",
+	"_work",
+	"_wr",
+	"_wri",
+	"_writ",
+	"a",
+	"a_",
+	"a__",
+	"a___",
+	"a____",
+	"ab",
+	"abl",
+	"able",
+	"able_",
+	"ac",
+	"act",
+	"act_",
+	"act__",
+	"ag",
+	"aga",
+	"agai",
+	"again",
+	"age",
+	"age_",
+	"age__",
+	"ages",
+	"ages_",
+	"ai",
+	"ain",
+	"ains",
+	"ainst",
+	"al",
+	"all",
+	"alle",
+	"alled",
+	"aly",
+	"alyz",
+	"alyze",
+	"am",
+	"ame",
+	"ame_",
+	"ame__",
+	"amp",
+	"ampl",
+	"ample",
+	"ams",
+	"ams_",
+	"ams__",
+	"an",
+	"an_",
+	"an__",
+	"an___",
+	"ana",
+	"anal",
+	"analy",
+	"and",
+	"and_",
+	"and__",
+	"ang",
+	"angu",
+	"angua",
+	"ank",
+	"anki",
+	"ankin",
+	"any",
+	"any_",
+	"any__",
+	"ar",
+	"arc",
+	"arch",
+	"arch_",
+	"are",
+	"ares",
+	"ares_",
+	"arg",
+	"arge",
+	"arge_",
+	"as",
+	"aso",
+	"ason",
+	"asona",
+	"ass",
+	"assa",
+	"assag",
+	"ast",
+	"ast_",
+	"ast__",
+	"at",
+	"at_",
+	"at__",
+	"at___",
+	"atc",
+	"atch",
+	"atch_",
+	"ate",
+	"ater",
+	"ater_",
+	"att",
+	"atte",
+	"atter",
+	"ay",
+	"ay_",
+	"ay__",
+	"ay___",
+	"b",
+	"b_",
+	"b__",
+	"b___",
+	"b____",
+	"bl",
+	"ble",
+	"ble_",
+	"ble__",
+	"bo",
+	"boo",
+	"book",
+	"book_",
+	"box",
+	"box_",
+	"box__",
+	"bu",
+	"bui",
+	"buil",
+	"build",
+	"c",
+	"ca",
+	"cal",
+	"call",
+	"calle",
+	"can",
+	"can_",
+	"can__",
+	"ce",
+	"ce_",
+	"ce__",
+	"ce___",
+	"ces",
+	"ces_",
+	"ces__",
+	"ch",
+	"ch_",
+	"ch__",
+	"ch___",
+	"co",
+	"com",
+	"come",
+	"comes",
+	"comm",
+	"commo",
+	"comp",
+	"compa",
+	"con",
+	"conf",
+	"confi",
+	"ct",
+	"ct_",
+	"ct__",
+	"ct___",
+	"cti",
+	"ctio",
+	"ction",
+	"cto",
+	"ctor",
+	"ctor_",
+	"ctors",
+	"cy",
+	"cy_",
+	"cy__",
+	"cy___",
+	"d",
+	"d_",
+	"d__",
+	"d___",
+	"d____",
+	"da",
+	"day",
+	"day_",
+	"day__",
+	"de",
+	"dea",
+	"dea_",
+	"dea__",
+	"den",
+	"denc",
+	"dence",
+	"det",
+	"dete",
+	"detec",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"ea",
+	"ea_",
+	"ea__",
+	"ea___",
+	"ear",
+	"earc",
+	"earch",
+	"eas",
+	"easo",
+	"eason",
+	"east",
+	"east_",
+	"eat",
+	"eat_",
+	"eat__",
+	"eb",
+	"eb_",
+	"eb__",
+	"eb___",
+	"ec",
+	"ect",
+	"ecti",
+	"ectio",
+	"ecto",
+	"ector",
+	"ed",
+	"ed_",
+	"ed__",
+	"ed___",
+	"el",
+	"ely",
+	"ely_",
+	"ely__",
+	"en",
+	"en_",
+	"en__",
+	"en___",
+	"enc",
+	"ence",
+	"ence_",
+	"ences",
+	"ency",
+	"ency_",
+	"end",
+	"end_",
+	"end__",
+	"eno",
+	"enou",
+	"enoug",
+	"ent",
+	"ent_",
+	"ent__",
+	"ente",
+	"enten",
+	"ep",
+	"epe",
+	"epea",
+	"epeat",
+	"eq",
+	"equ",
+	"eque",
+	"equen",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"ern",
+	"erns",
+	"erns_",
+	"erp",
+	"erpr",
+	"erpri",
+	"ers",
+	"ers_",
+	"ers__",
+	"ery",
+	"ery_",
+	"ery__",
+	"eryd",
+	"eryda",
+	"es",
+	"es_",
+	"es__",
+	"es___",
+	"ese",
+	"ese_",
+	"ese__",
+	"et",
+	"ete",
+	"etec",
+	"etect",
+	"eth",
+	"ethe",
+	"ether",
+	"ets",
+	"ets_",
+	"ets__",
+	"ett",
+	"ette",
+	"etter",
+	"ev",
+	"eve",
+	"even",
+	"even_",
+	"ever",
+	"every",
+	"ew",
+	"ew_",
+	"ew__",
+	"ew___",
+	"ex",
+	"ext",
+	"ext_",
+	"ext__",
+	"f",
+	"f_",
+	"f__",
+	"f___",
+	"f____",
+	"fi",
+	"fid",
+	"fide",
+	"fiden",
+	"fil",
+	"file",
+	"files",
+	"fin",
+	"fing",
+	"finge",
+	"fo",
+	"for",
+	"for_",
+	"for__",
+	"fr",
+	"fre",
+	"freq",
+	"frequ",
+	"fro",
+	"from",
+	"from_",
+	"g",
+	"g_",
+	"g__",
+	"g___",
+	"g____",
+	"ga",
+	"gai",
+	"gain",
+	"gains",
+	"ge",
+	"ge_",
+	"ge__",
+	"ge___",
+	"ger",
+	"gerp",
+	"gerpr",
+	"ges",
+	"ges_",
+	"ges__",
+	"gh",
+	"gh_",
+	"gh__",
+	"gh___",
+	"gi",
+	"giv",
+	"give",
+	"given",
+	"gl",
+	"gle",
+	"gle_",
+	"gle__",
+	"gr",
+	"gra",
+	"gram",
+	"grams",
+	"gu",
+	"gua",
+	"guag",
+	"guage",
+	"h",
+	"h_",
+	"h__",
+	"h___",
+	"h____",
+	"ha",
+	"hat",
+	"hat_",
+	"hat__",
+	"he",
+	"he_",
+	"he__",
+	"he___",
+	"her",
+	"her_",
+	"her__",
+	"hes",
+	"hese",
+	"hese_",
+	"het",
+	"heth",
+	"hethe",
+	"hi",
+	"hic",
+	"hich",
+	"hich_",
+	"ho",
+	"hor",
+	"hort",
+	"hort_",
+	"i",
+	"ic",
+	"ich",
+	"ich_",
+	"ich__",
+	"id",
+	"ide",
+	"idea",
+	"idea_",
+	"iden",
+	"idenc",
+	"il",
+	"ild",
+	"ild_",
+	"ild__",
+	"ile",
+	"iles",
+	"iles_",
+	"in",
+	"in_",
+	"in__",
+	"in___",
+	"ing",
+	"ing_",
+	"ing__",
+	"inge",
+	"inger",
+	"ingl",
+	"ingle",
+	"ins",
+	"inst",
+	"inst_",
+	"int",
+	"int_",
+	"int__",
+	"into",
+	"into_",
+	"io",
+	"ion",
+	"ion_",
+	"ion__",
+	"ip",
+	"ipp",
+	"ippe",
+	"ippet",
+	"it",
+	"ith",
+	"ith_",
+	"ith__",
+	"iti",
+	"itin",
+	"iting",
+	"its",
+	"its_",
+	"its__",
+	"iv",
+	"ive",
+	"iven",
+	"iven_",
+	"k",
+	"k_",
+	"k__",
+	"k___",
+	"k____",
+	"ki",
+	"kin",
+	"king",
+	"king_",
+	"kn",
+	"kno",
+	"know",
+	"known",
+	"ks",
+	"ks_",
+	"ks__",
+	"ks___",
+	"l",
+	"la",
+	"lan",
+	"lang",
+	"langu",
+	"lar",
+	"larg",
+	"large",
+	"lat",
+	"late",
+	"later",
+	"ld",
+	"ld_",
+	"ld__",
+	"ld___",
+	"le",
+	"le_",
+	"le__",
+	"le___",
+	"lea",
+	"leas",
+	"least",
+	"led",
+	"led_",
+	"led__",
+	"les",
+	"les_",
+	"les__",
+	"let",
+	"lett",
+	"lette",
+	"ll",
+	"lle",
+	"lled",
+	"lled_",
+	"ly",
+	"ly_",
+	"ly__",
+	"ly___",
+	"lyz",
+	"lyze",
+	"lyzes",
+	"m",
+	"m_",
+	"m__",
+	"m___",
+	"m____",
+	"ma",
+	"man",
+	"many",
+	"many_",
+	"mat",
+	"matc",
+	"match",
+	"me",
+	"me_",
+	"me__",
+	"me___",
+	"mes",
+	"mes_",
+	"mes__",
+	"mm",
+	"mmo",
+	"mmon",
+	"mmon_",
+	"mo",
+	"mon",
+	"mon_",
+	"mon__",
+	"mos",
+	"most",
+	"most_",
+	"mp",
+	"mpa",
+	"mpac",
+	"mpact",
+	"mpar",
+	"mpare",
+	"mpl",
+	"mple",
+	"mple_",
+	"ms",
+	"ms_",
+	"ms__",
+	"ms___",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"nab",
+	"nabl",
+	"nable",
+	"nal",
+	"naly",
+	"nalyz",
+	"nc",
+	"nce",
+	"nce_",
+	"nce__",
+	"nces",
+	"nces_",
+	"ncy",
+	"ncy_",
+	"ncy__",
+	"nd",
+	"nd_",
+	"nd__",
+	"nd___",
+	"ne",
+	"new",
+	"new_",
+	"new__",
+	"nf",
+	"nfi",
+	"nfid",
+	"nfide",
+	"ng",
+	"ng_",
+	"ng__",
+	"ng___",
+	"nge",
+	"nger",
+	"ngerp",
+	"ngl",
+	"ngle",
+	"ngle_",
+	"ngu",
+	"ngua",
+	"nguag",
+	"ni",
+	"nip",
+	"nipp",
+	"nippe",
+	"nk",
+	"nki",
+	"nkin",
+	"nking",
+	"no",
+	"nou",
+	"noug",
+	"nough",
+	"now",
+	"nown",
+	"nown_",
+	"ns",
+	"ns_",
+	"ns__",
+	"ns___",
+	"nst",
+	"nst_",
+	"nst__",
+	"nt",
+	"nt_",
+	"nt__",
+	"nt___",
+	"nte",
+	"nten",
+	"ntenc",
+	"nto",
+	"nto_",
+	"nto__",
+	"ny",
+	"ny_",
+	"ny__",
+	"ny___",
+	"o",
+	"o_",
+	"o__",
+	"o___",
+	"o____",
+	"od",
+	"od_",
+	"od__",
+	"od___",
+	"of",
+	"of_",
+	"of__",
+	"of___",
+	"ofi",
+	"ofil",
+	"ofile",
+	"ok",
+	"ok_",
+	"ok__",
+	"ok___",
+	"om",
+	"om_",
+	"om__",
+	"om___",
+	"ome",
+	"omes",
+	"omes_",
+	"omm",
+	"ommo",
+	"ommon",
+	"omp",
+	"ompa",
+	"ompac",
+	"ompar",
+	"on",
+	"on_",
+	"on__",
+	"on___",
+	"ona",
+	"onab",
+	"onabl",
+	"onf",
+	"onfi",
+	"onfid",
+	"oo",
+	"ood",
+	"ood_",
+	"ood__",
+	"ook",
+	"ook_",
+	"ook__",
+	"or",
+	"or_",
+	"or__",
+	"or___",
+	"ork",
+	"orks",
+	"orks_",
+	"ors",
+	"ors_",
+	"ors__",
+	"ort",
+	"ort_",
+	"ort__",
+	"os",
+	"ost",
+	"ost_",
+	"ost__",
+	"ou",
+	"oug",
+	"ough",
+	"ough_",
+	"ow",
+	"own",
+	"own_",
+	"own__",
+	"ox",
+	"ox_",
+	"ox__",
+	"ox___",
+	"p",
+	"pa",
+	"pac",
+	"pact",
+	"pact_",
+	"pag",
+	"page",
+	"page_",
+	"par",
+	"pare",
+	"pares",
+	"pas",
+	"pass",
+	"passa",
+	"pat",
+	"patt",
+	"patte",
+	"pe",
+	"pea",
+	"peat",
+	"peat_",
+	"ped",
+	"ped_",
+	"ped__",
+	"pet",
+	"pets",
+	"pets_",
+	"pl",
+	"ple",
+	"ple_",
+	"ple__",
+	"pp",
+	"ppe",
+	"ppet",
+	"ppets",
+	"pr",
+	"pri",
+	"prin",
+	"print",
+	"pro",
+	"prof",
+	"profi",
+	"q",
+	"qu",
+	"que",
+	"quen",
+	"quenc",
+	"quent",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"ram",
+	"rams",
+	"rams_",
+	"ran",
+	"rank",
+	"ranki",
+	"rc",
+	"rch",
+	"rch_",
+	"rch__",
+	"re",
+	"rea",
+	"reas",
+	"reaso",
+	"rel",
+	"rely",
+	"rely_",
+	"rep",
+	"repe",
+	"repea",
+	"req",
+	"requ",
+	"reque",
+	"res",
+	"res_",
+	"res__",
+	"rg",
+	"rge",
+	"rge_",
+	"rge__",
+	"ri",
+	"rin",
+	"rint",
+	"rint_",
+	"rit",
+	"riti",
+	"ritin",
+	"rk",
+	"rks",
+	"rks_",
+	"rks__",
+	"rn",
+	"rns",
+	"rns_",
+	"rns__",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"rom",
+	"rom_",
+	"rom__",
+	"rp",
+	"rpr",
+	"rpri",
+	"rprin",
+	"rs",
+	"rs_",
+	"rs__",
+	"rs___",
+	"rt",
+	"rt_",
+	"rt__",
+	"rt___",
+	"ry",
+	"ry_",
+	"ry__",
+	"ry___",
+	"ryd",
+	"ryda",
+	"ryday",
+	"s",
+	"s_",
+	"s__",
+	"s___",
+	"s____",
+	"sa",
+	"sag",
+	"sage",
+	"sage_",
+	"sam",
+	"same",
+	"same_",
+	"samp",
+	"sampl",
+	"se",
+	"se_",
+	"se__",
+	"se___",
+	"sea",
+	"sear",
+	"searc",
+	"sen",
+	"sent",
+	"sente",
+	"seq",
+	"sequ",
+	"seque",
+	"sh",
+	"sho",
+	"shor",
+	"short",
+	"si",
+	"sin",
+	"sing",
+	"singl",
+	"sn",
+	"sni",
+	"snip",
+	"snipp",
+	"so",
+	"son",
+	"sona",
+	"sonab",
+	"ss",
+	"ssa",
+	"ssag",
+	"ssage",
+	"st",
+	"st_",
+	"st__",
+	"st___",
+	"sta",
+	"stab",
+	"stabl",
+	"t",
+	"t_",
+	"t__",
+	"t___",
+	"t____",
+	"ta",
+	"tab",
+	"tabl",
+	"table",
+	"tc",
+	"tch",
+	"tch_",
+	"tch__",
+	"te",
+	"tec",
+	"tect",
+	"tecti",
+	"tecto",
+	"ten",
+	"tenc",
+	"tence",
+	"tend",
+	"tend_",
+	"ter",
+	"ter_",
+	"ter__",
+	"tern",
+	"terns",
+	"ters",
+	"ters_",
+	"tex",
+	"text",
+	"text_",
+	"th",
+	"th_",
+	"th__",
+	"th___",
+	"tha",
+	"that",
+	"that_",
+	"the",
+	"the_",
+	"the__",
+	"ther",
+	"ther_",
+	"thes",
+	"these",
+	"ti",
+	"tin",
+	"ting",
+	"ting_",
+	"tio",
+	"tion",
+	"tion_",
+	"to",
+	"to_",
+	"to__",
+	"to___",
+	"tor",
+	"tor_",
+	"tor__",
+	"tors",
+	"tors_",
+	"ts",
+	"ts_",
+	"ts__",
+	"ts___",
+	"tt",
+	"tte",
+	"tter",
+	"tter_",
+	"ttern",
+	"tters",
+	"ty",
+	"typ",
+	"type",
+	"typed",
+	"u",
+	"ua",
+	"uag",
+	"uage",
+	"uage_",
+	"uages",
+	"ue",
+	"uen",
+	"uenc",
+	"uence",
+	"uency",
+	"uent",
+	"uent_",
+	"ug",
+	"ugh",
+	"ugh_",
+	"ugh__",
+	"ui",
+	"uil",
+	"uild",
+	"uild_",
+	"v",
+	"ve",
+	"ven",
+	"ven_",
+	"ven__",
+	"ver",
+	"very",
+	"very_",
+	"veryd",
+	"w",
+	"w_",
+	"w__",
+	"w___",
+	"w____",
+	"we",
+	"web",
+	"web_",
+	"web__",
+	"wh",
+	"whe",
+	"whet",
+	"wheth",
+	"whi",
+	"whic",
+	"which",
+	"wi",
+	"wit",
+	"with",
+	"with_",
+	"wn",
+	"wn_",
+	"wn__",
+	"wn___",
+	"wo",
+	"wor",
+	"work",
+	"works",
+	"wr",
+	"wri",
+	"writ",
+	"writi",
+	"x",
+	"x_",
+	"x__",
+	"x___",
+	"x____",
+	"xt",
+	"xt_",
+	"xt__",
+	"xt___",
+	"y",
+	"y_",
+	"y__",
+	"y___",
+	"y____",
+	"yd",
+	"yda",
+	"yday",
+	"yday_",
+	"yp",
+	"ype",
+	"yped",
+	"yped_",
+	"yz",
+	"yze",
+	"yzes",
+	"yzes_",
+	"z",
+	"ze",
+	"zes",
+	"zes_",
+	"zes__",
+}
+
+var englishRanks = []uint16{
+	1558,
+	1557,
+	1556,
+	1555,
+	1554,
+	1553,
+	1552,
+	1551,
+	1550,
+	1549,
+	1548,
+	1547,
+	1546,
+	1545,
+	1544,
+	1543,
+	1542,
+	1541,
+	1540,
+	1539,
+	1538,
+	1537,
+	1536,
+	1535,
+	1534,
+	1533,
+	1532,
+	1531,
+	1530,
+	1529,
+	1528,
+	1527,
+	1526,
+	1525,
+	1524,
+	1523,
+	1522,
+	1521,
+	1520,
+	1519,
+	1518,
+	1517,
+	1516,
+	1515,
+	1514,
+	1513,
+	1512,
+	1511,
+	1510,
+	1509,
+	1508,
+	1507,
+	1506,
+	1505,
+	1504,
+	1503,
+	1502,
+	1501,
+	1500,
+	1499,
+	24,
+	358,
+	101,
+	357,
+	356,
+	75,
+	355,
+	209,
+	1498,
+	74,
+	354,
+	353,
+	100,
+	208,
+	207,
+	43,
+	59,
+	1497,
+	99,
+	23,
+	58,
+	633,
+	206,
+	352,
+	632,
+	1496,
+	98,
+	631,
+	150,
+	351,
+	350,
+	349,
+	1495,
+	630,
+	73,
+	629,
+	628,
+	205,
+	348,
+	1494,
+	627,
+	204,
+	1493,
+	626,
+	1492,
+	1491,
+	1490,
+	72,
+	149,
+	347,
+	346,
+	625,
+	1489,
+	345,
+	624,
+	1488,
+	97,
+	148,
+	1487,
+	1486,
+	203,
+	344,
+	1485,
+	202,
+	1484,
+	343,
+	42,
+	623,
+	342,
+	341,
+	1483,
+	1482,
+	1481,
+	57,
+	201,
+	340,
+	622,
+	1480,
+	1479,
+	1478,
+	96,
+	1477,
+	621,
+	620,
+	1476,
+	1475,
+	22,
+	56,
+	55,
+	619,
+	618,
+	200,
+	1474,
+	617,
+	1473,
+	339,
+	616,
+	1472,
+	1471,
+	1470,
+	1469,
+	95,
+	615,
+	1468,
+	1467,
+	147,
+	199,
+	1466,
+	338,
+	337,
+	336,
+	335,
+	1465,
+	1464,
+	614,
+	613,
+	71,
+	612,
+	611,
+	610,
+	609,
+	198,
+	608,
+	607,
+	334,
+	1463,
+	1462,
+	606,
+	605,
+	197,
+	1461,
+	1460,
+	604,
+	1459,
+	1458,
+	1457,
+	1456,
+	1455,
+	1454,
+	1453,
+	70,
+	146,
+	333,
+	1452,
+	1451,
+	332,
+	1450,
+	603,
+	331,
+	602,
+	1449,
+	1448,
+	1447,
+	1446,
+	330,
+	601,
+	600,
+	1445,
+	1444,
+	94,
+	145,
+	144,
+	1443,
+	1442,
+	1441,
+	1440,
+	196,
+	329,
+	1439,
+	1438,
+	1437,
+	1436,
+	1435,
+	195,
+	1434,
+	1433,
+	328,
+	1432,
+	1431,
+	1430,
+	41,
+	599,
+	598,
+	327,
+	1429,
+	1428,
+	1427,
+	326,
+	325,
+	1426,
+	1425,
+	1424,
+	1423,
+	1422,
+	1421,
+	54,
+	194,
+	1420,
+	324,
+	323,
+	1419,
+	597,
+	596,
+	595,
+	1418,
+	1417,
+	1416,
+	1415,
+	1414,
+	93,
+	1413,
+	1412,
+	594,
+	1411,
+	1410,
+	593,
+	592,
+	1409,
+	1408,
+	1407,
+	1406,
+	21,
+	53,
+	52,
+	51,
+	591,
+	590,
+	589,
+	193,
+	1405,
+	1404,
+	588,
+	587,
+	1403,
+	1402,
+	322,
+	586,
+	1401,
+	1400,
+	1399,
+	1398,
+	1397,
+	1396,
+	1395,
+	92,
+	585,
+	1394,
+	1393,
+	1392,
+	1391,
+	143,
+	192,
+	1390,
+	1389,
+	584,
+	1388,
+	1387,
+	321,
+	320,
+	319,
+	318,
+	317,
+	1386,
+	1385,
+	1384,
+	583,
+	582,
+	1383,
+	1382,
+	69,
+	581,
+	580,
+	579,
+	578,
+	577,
+	576,
+	191,
+	575,
+	574,
+	573,
+	572,
+	316,
+	1381,
+	1380,
+	1379,
+	571,
+	570,
+	569,
+	190,
+	1378,
+	1377,
+	1376,
+	568,
+	1375,
+	1374,
+	1373,
+	1372,
+	1371,
+	1370,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	68,
+	142,
+	315,
+	314,
+	1364,
+	1363,
+	1362,
+	1361,
+	313,
+	1360,
+	1359,
+	567,
+	566,
+	312,
+	565,
+	1358,
+	1357,
+	1356,
+	1355,
+	1354,
+	1353,
+	1352,
+	311,
+	564,
+	563,
+	562,
+	1351,
+	1350,
+	1349,
+	91,
+	141,
+	140,
+	139,
+	1348,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	189,
+	310,
+	1342,
+	1341,
+	1340,
+	1339,
+	1338,
+	1337,
+	1336,
+	1335,
+	1334,
+	188,
+	1333,
+	1332,
+	1331,
+	309,
+	1330,
+	1329,
+	1328,
+	1327,
+	1326,
+	1325,
+	40,
+	561,
+	560,
+	1324,
+	1323,
+	308,
+	1322,
+	1321,
+	1320,
+	1319,
+	1318,
+	1317,
+	307,
+	306,
+	305,
+	1316,
+	1315,
+	1314,
+	1313,
+	1312,
+	1311,
+	1310,
+	1309,
+	1308,
+	50,
+	187,
+	1307,
+	1306,
+	304,
+	303,
+	302,
+	1305,
+	1304,
+	559,
+	1303,
+	1302,
+	558,
+	557,
+	556,
+	1301,
+	1300,
+	1299,
+	1298,
+	1297,
+	1296,
+	1295,
+	90,
+	1294,
+	1293,
+	1292,
+	555,
+	1291,
+	1290,
+	1289,
+	1288,
+	554,
+	553,
+	552,
+	1287,
+	1286,
+	1285,
+	1284,
+	1283,
+	1282,
+	2,
+	39,
+	38,
+	37,
+	36,
+	551,
+	550,
+	549,
+	548,
+	1281,
+	1280,
+	1279,
+	1278,
+	67,
+	547,
+	546,
+	545,
+	117,
+	138,
+	137,
+	1277,
+	1276,
+	544,
+	543,
+	542,
+	541,
+	540,
+	1275,
+	1274,
+	1273,
+	1272,
+	1271,
+	1270,
+	186,
+	1269,
+	1268,
+	1267,
+	1266,
+	1265,
+	1264,
+	539,
+	538,
+	537,
+	35,
+	1263,
+	1262,
+	1261,
+	1260,
+	1259,
+	1258,
+	536,
+	535,
+	534,
+	185,
+	184,
+	183,
+	1257,
+	1256,
+	1255,
+	533,
+	532,
+	531,
+	301,
+	1254,
+	1253,
+	1252,
+	1251,
+	1250,
+	1249,
+	1248,
+	1247,
+	1246,
+	300,
+	1245,
+	1244,
+	1243,
+	1242,
+	1241,
+	1240,
+	1239,
+	1238,
+	1237,
+	136,
+	530,
+	529,
+	528,
+	1236,
+	1235,
+	1234,
+	1233,
+	1232,
+	1231,
+	1230,
+	1229,
+	1228,
+	1227,
+	1226,
+	1225,
+	1224,
+	116,
+	1223,
+	1222,
+	1221,
+	1220,
+	527,
+	526,
+	525,
+	524,
+	523,
+	1219,
+	1218,
+	1217,
+	1216,
+	1215,
+	1214,
+	1213,
+	1212,
+	1211,
+	1210,
+	11,
+	522,
+	1209,
+	1208,
+	1207,
+	1206,
+	1205,
+	1204,
+	299,
+	521,
+	520,
+	519,
+	1203,
+	1202,
+	1201,
+	298,
+	297,
+	296,
+	295,
+	135,
+	182,
+	1200,
+	1199,
+	1198,
+	1197,
+	518,
+	517,
+	1196,
+	1195,
+	1194,
+	181,
+	1193,
+	1192,
+	1191,
+	1190,
+	1189,
+	1188,
+	516,
+	515,
+	1187,
+	1186,
+	1185,
+	1184,
+	1183,
+	1182,
+	32,
+	89,
+	88,
+	87,
+	86,
+	1181,
+	1180,
+	1179,
+	1178,
+	134,
+	1177,
+	1176,
+	1175,
+	1174,
+	1173,
+	1172,
+	294,
+	293,
+	292,
+	1,
+	20,
+	19,
+	18,
+	17,
+	133,
+	1171,
+	1170,
+	1169,
+	1168,
+	1167,
+	1166,
+	514,
+	1165,
+	1164,
+	1163,
+	1162,
+	1161,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	1155,
+	291,
+	290,
+	1154,
+	1153,
+	513,
+	512,
+	511,
+	510,
+	509,
+	508,
+	1152,
+	1151,
+	1150,
+	1149,
+	49,
+	507,
+	506,
+	505,
+	180,
+	289,
+	504,
+	1148,
+	1147,
+	1146,
+	1145,
+	1144,
+	1143,
+	1142,
+	1141,
+	1140,
+	503,
+	1139,
+	1138,
+	1137,
+	1136,
+	1135,
+	1134,
+	1133,
+	1132,
+	288,
+	287,
+	286,
+	285,
+	66,
+	284,
+	283,
+	282,
+	1131,
+	1130,
+	1129,
+	502,
+	501,
+	500,
+	1128,
+	1127,
+	1126,
+	499,
+	1125,
+	1124,
+	1123,
+	1122,
+	85,
+	115,
+	114,
+	113,
+	1121,
+	1120,
+	1119,
+	84,
+	281,
+	280,
+	279,
+	1118,
+	1117,
+	1116,
+	1115,
+	1114,
+	1113,
+	498,
+	497,
+	496,
+	495,
+	494,
+	1112,
+	1111,
+	1110,
+	1109,
+	1108,
+	1107,
+	1106,
+	1105,
+	278,
+	277,
+	276,
+	275,
+	25,
+	132,
+	131,
+	130,
+	129,
+	179,
+	1104,
+	1103,
+	1102,
+	1101,
+	1100,
+	1099,
+	493,
+	492,
+	491,
+	490,
+	489,
+	488,
+	487,
+	178,
+	486,
+	485,
+	484,
+	483,
+	482,
+	481,
+	9,
+	480,
+	479,
+	478,
+	477,
+	476,
+	475,
+	474,
+	473,
+	65,
+	112,
+	111,
+	110,
+	472,
+	471,
+	470,
+	1098,
+	1097,
+	1096,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	1088,
+	1087,
+	1086,
+	1085,
+	1084,
+	469,
+	468,
+	467,
+	466,
+	177,
+	176,
+	175,
+	174,
+	16,
+	109,
+	108,
+	107,
+	106,
+	1083,
+	1082,
+	1081,
+	1080,
+	128,
+	465,
+	464,
+	463,
+	1079,
+	1078,
+	1077,
+	1076,
+	1075,
+	1074,
+	1073,
+	1072,
+	1071,
+	1070,
+	1069,
+	1068,
+	1067,
+	274,
+	273,
+	272,
+	271,
+	8,
+	1066,
+	1065,
+	1064,
+	1063,
+	462,
+	461,
+	1062,
+	1061,
+	1060,
+	1059,
+	460,
+	1058,
+	1057,
+	1056,
+	1055,
+	1054,
+	1053,
+	34,
+	1052,
+	1051,
+	1050,
+	127,
+	459,
+	458,
+	457,
+	456,
+	1049,
+	1048,
+	455,
+	454,
+	453,
+	270,
+	452,
+	451,
+	1047,
+	1046,
+	1045,
+	1044,
+	1043,
+	1042,
+	1041,
+	1040,
+	1039,
+	1038,
+	173,
+	450,
+	449,
+	448,
+	1037,
+	1036,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	1030,
+	1029,
+	1028,
+	172,
+	1027,
+	1026,
+	1025,
+	1024,
+	1023,
+	1022,
+	1021,
+	1020,
+	1019,
+	1018,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	1012,
+	10,
+	126,
+	269,
+	268,
+	267,
+	1011,
+	1010,
+	1009,
+	1008,
+	1007,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	64,
+	171,
+	170,
+	169,
+	1001,
+	1000,
+	999,
+	998,
+	997,
+	996,
+	995,
+	994,
+	993,
+	447,
+	446,
+	445,
+	992,
+	991,
+	990,
+	989,
+	444,
+	988,
+	987,
+	986,
+	985,
+	984,
+	983,
+	30,
+	443,
+	442,
+	441,
+	440,
+	439,
+	982,
+	981,
+	980,
+	979,
+	978,
+	977,
+	438,
+	976,
+	975,
+	974,
+	973,
+	972,
+	971,
+	970,
+	969,
+	968,
+	967,
+	437,
+	966,
+	965,
+	964,
+	963,
+	962,
+	961,
+	266,
+	436,
+	960,
+	959,
+	958,
+	957,
+	956,
+	955,
+	954,
+	435,
+	434,
+	433,
+	432,
+	4,
+	48,
+	47,
+	46,
+	45,
+	431,
+	953,
+	952,
+	951,
+	950,
+	949,
+	948,
+	168,
+	265,
+	430,
+	429,
+	947,
+	946,
+	945,
+	944,
+	943,
+	264,
+	263,
+	262,
+	261,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	935,
+	63,
+	428,
+	427,
+	426,
+	425,
+	424,
+	423,
+	934,
+	933,
+	932,
+	167,
+	166,
+	165,
+	931,
+	930,
+	929,
+	928,
+	927,
+	926,
+	925,
+	924,
+	422,
+	923,
+	922,
+	921,
+	920,
+	919,
+	918,
+	260,
+	917,
+	916,
+	915,
+	421,
+	420,
+	419,
+	125,
+	259,
+	258,
+	257,
+	914,
+	913,
+	912,
+	911,
+	910,
+	909,
+	418,
+	417,
+	416,
+	415,
+	5,
+	256,
+	255,
+	254,
+	253,
+	908,
+	907,
+	906,
+	905,
+	105,
+	124,
+	123,
+	122,
+	904,
+	903,
+	902,
+	901,
+	900,
+	899,
+	898,
+	104,
+	414,
+	413,
+	412,
+	897,
+	896,
+	895,
+	894,
+	893,
+	892,
+	411,
+	410,
+	891,
+	890,
+	121,
+	252,
+	251,
+	250,
+	889,
+	888,
+	887,
+	886,
+	885,
+	884,
+	409,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	62,
+	164,
+	163,
+	162,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	249,
+	248,
+	247,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	862,
+	861,
+	860,
+	859,
+	858,
+	857,
+	856,
+	31,
+	120,
+	855,
+	854,
+	853,
+	852,
+	851,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	844,
+	843,
+	842,
+	841,
+	246,
+	840,
+	839,
+	838,
+	837,
+	836,
+	835,
+	834,
+	833,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	826,
+	825,
+	824,
+	245,
+	408,
+	407,
+	406,
+	823,
+	822,
+	821,
+	244,
+	243,
+	242,
+	241,
+	405,
+	820,
+	6,
+	83,
+	82,
+	81,
+	80,
+	240,
+	404,
+	403,
+	402,
+	819,
+	818,
+	817,
+	816,
+	815,
+	814,
+	813,
+	103,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	401,
+	400,
+	399,
+	803,
+	802,
+	801,
+	800,
+	799,
+	798,
+	797,
+	239,
+	398,
+	397,
+	396,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	786,
+	238,
+	785,
+	784,
+	783,
+	395,
+	394,
+	393,
+	392,
+	391,
+	390,
+	389,
+	388,
+	387,
+	386,
+	385,
+	237,
+	236,
+	235,
+	234,
+	384,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	7,
+	29,
+	28,
+	27,
+	26,
+	233,
+	776,
+	775,
+	774,
+	383,
+	773,
+	772,
+	771,
+	770,
+	161,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	232,
+	231,
+	230,
+	229,
+	757,
+	756,
+	755,
+	754,
+	753,
+	752,
+	751,
+	750,
+	749,
+	748,
+	747,
+	746,
+	745,
+	744,
+	743,
+	742,
+	119,
+	160,
+	159,
+	158,
+	741,
+	740,
+	739,
+	3,
+	15,
+	14,
+	13,
+	12,
+	738,
+	737,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	33,
+	228,
+	227,
+	730,
+	382,
+	381,
+	729,
+	728,
+	727,
+	726,
+	157,
+	380,
+	379,
+	725,
+	724,
+	723,
+	722,
+	226,
+	225,
+	224,
+	102,
+	378,
+	377,
+	376,
+	721,
+	720,
+	719,
+	223,
+	718,
+	717,
+	716,
+	715,
+	714,
+	713,
+	375,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	118,
+	222,
+	221,
+	220,
+	374,
+	706,
+	705,
+	704,
+	703,
+	373,
+	372,
+	371,
+	370,
+	219,
+	218,
+	217,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	696,
+	61,
+	156,
+	155,
+	154,
+	216,
+	695,
+	215,
+	214,
+	369,
+	694,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	686,
+	685,
+	684,
+	683,
+	153,
+	152,
+	368,
+	367,
+	366,
+	365,
+	364,
+	682,
+	681,
+	60,
+	680,
+	679,
+	678,
+	677,
+	676,
+	675,
+	674,
+	673,
+	363,
+	672,
+	671,
+	670,
+	669,
+	668,
+	667,
+	362,
+	361,
+	360,
+	359,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	151,
+	654,
+	653,
+	652,
+	651,
+	213,
+	212,
+	211,
+	210,
+	44,
+	79,
+	78,
+	77,
+	76,
+	650,
+	649,
+	648,
+	647,
+	646,
+	645,
+	644,
+	643,
+	642,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	635,
+	634,
+}
+
+var frenchTokens = []string{
+	"E",
+	"En",
+	"En_",
+	"En__",
+	"En___",
+	"L",
+	"La",
+	"La_",
+	"La__",
+	"La___",
+	"Le",
+	"Les",
+	"Les_",
+	"Les__",
+	"_E",
+	"_En",
+	"_En_",
+	"_En__",
+	"_L",
+	"_La",
+	"_La_",
+	"_La__",
+	"_Le",
+	"_Les",
+	"_Les_",
+	"__E",
+	"__En",
+	"__En_",
+	"__L",
+	"__La",
+	"__La_",
+	"__Le",
+	"__Les",
+	"___E",
+	"___En",
+	"___L",
+	"___La",
+	"___Le",
+	"____E",
+	"____L",
+	"____a",
+	"____b",
+	"____c",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____i",
+	"____l",
+	"____m",
+	"____n",
+	"____o",
+	"____p",
+	"____q",
+	"____r",
+	"____s",
+	"____t",
+	"____u",
+	"____w",
+	"____\xc3",
+	"___a",
+	"___an",
+	"___ap",
+	"___au",
+	"___av",
+	"___b",
+	"___ba",
+	"___bo",
+	"___c",
+	"___ce",
+	"___cl",
+	"___co",
+	"___d",
+	"___d_",
+	"___da",
+	"___de",
+	"___do",
+	"___du",
+	"___d\xc3",
+	"___e",
+	"___em",
+	"___en",
+	"___et",
+	"___ex",
+	"___f",
+	"___fo",
+	"___fr",
+	"___g",
+	"___gr",
+	"___i",
+	"___id",
+	"___l",
+	"___la",
+	"___le",
+	"___li",
+	"___m",
+	"___mo",
+	"___m\xc3",
+	"___n",
+	"___n_",
+	"___no",
+	"___o",
+	"___on",
+	"___ou",
+	"___p",
+	"___pa",
+	"___pe",
+	"___ph",
+	"___pl",
+	"___po",
+	"___pr",
+	"___q",
+	"___qu",
+	"___r",
+	"___ra",
+	"___re",
+	"___r\xc3",
+	"___s",
+	"___s_",
+	"___se",
+	"___si",
+	"___st",
+	"___su",
+	"___s\xc3",
+	"___t",
+	"___ta",
+	"___te",
+	"___tr",
+	"___u",
+	"___un",
+	"___w",
+	"___we",
+	"___\xc3",
+	"___à",
+	"___é",
+	"__a",
+	"__an",
+	"__ana",
+	"__ap",
+	"__app",
+	"__au",
+	"__au_",
+	"__av",
+	"__ave",
+	"__b",
+	"__ba",
+	"__bar",
+	"__bo",
+	"__bon",
+	"__c",
+	"__ce",
+	"__ces",
+	"__cet",
+	"__cl",
+	"__cla",
+	"__co",
+	"__com",
+	"__con",
+	"__cou",
+	"__d",
+	"__d_",
+	"__d__",
+	"__da",
+	"__dan",
+	"__de",
+	"__de_",
+	"__des",
+	"__do",
+	"__don",
+	"__du",
+	"__du_",
+	"__d\xc3",
+	"__dé",
+	"__e",
+	"__em",
+	"__emp",
+	"__en",
+	"__ens",
+	"__et",
+	"__et_",
+	"__ex",
+	"__ext",
+	"__f",
+	"__fo",
+	"__fon",
+	"__fr",
+	"__fr\xc3",
+	"__g",
+	"__gr",
+	"__gra",
+	"__i",
+	"__id",
+	"__id\xc3",
+	"__l",
+	"__la",
+	"__la_",
+	"__lan",
+	"__le",
+	"__let",
+	"__li",
+	"__liv",
+	"__m",
+	"__mo",
+	"__moi",
+	"__mot",
+	"__m\xc3",
+	"__mê",
+	"__n",
+	"__n_",
+	"__n__",
+	"__no",
+	"__nom",
+	"__nou",
+	"__o",
+	"__on",
+	"__ont",
+	"__ou",
+	"__ou_",
+	"__p",
+	"__pa",
+	"__pag",
+	"__pas",
+	"__pe",
+	"__peu",
+	"__ph",
+	"__phr",
+	"__pl",
+	"__plu",
+	"__po",
+	"__pou",
+	"__pr",
+	"__pro",
+	"__q",
+	"__qu",
+	"__qui",
+	"__quo",
+	"__r",
+	"__ra",
+	"__rai",
+	"__re",
+	"__rec",
+	"__r\xc3",
+	"__ré",
+	"__s",
+	"__s_",
+	"__s__",
+	"__se",
+	"__se_",
+	"__ses",
+	"__si",
+	"__sim",
+	"__st",
+	"__sta",
+	"__su",
+	"__suf",
+	"__sur",
+	"__s\xc3",
+	"__sé",
+	"__t",
+	"__ta",
+	"__tap",
+	"__te",
+	"__ten",
+	"__tex",
+	"__tr",
+	"__tr\xc3",
+	"__u",
+	"__un",
+	"__un_",
+	"__une",
+	"__w",
+	"__we",
+	"__web",
+	"__\xc3",
+	"__à",
+	"__à_",
+	"__é",
+	"__éc",
+	"_a",
+	"_an",
+	"_ana",
+	"_anal",
+	"_ap",
+	"_app",
+	"_appe",
+	"_appu",
+	"_au",
+	"_au_",
+	"_au__",
+	"_av",
+	"_ave",
+	"_avec",
+	"_b",
+	"_ba",
+	"_bar",
+	"_barr",
+	"_bo",
+	"_bon",
+	"_bons",
+	"_c",
+	"_ce",
+	"_ces",
+	"_ces_",
+	"_cet",
+	"_cett",
+	"_cl",
+	"_cla",
+	"_clas",
+	"_co",
+	"_com",
+	"_comp",
+	"_con",
+	"_conf",
+	"_conn",
+	"_cons",
+	"_cou",
+	"_cour",
+	"_d",
+	"_d_",
+	"_d__",
+	"_d___",
+	"_da",
+	"_dan",
+	"_dans",
+	"_de",
+	"_de_",
+	"_de__",
+	"_des",
+	"_des_",
+	"_do",
+	"_don",
+	"_donn",
+	"_du",
+	"_du_",
+	"_du__",
+	"_d\xc3",
+	"_dé",
+	"_dét",
+	"_e",
+	"_em",
+	"_emp",
+	"_empr",
+	"_en",
+	"_ens",
+	"_ensu",
+	"_et",
+	"_et_",
+	"_et__",
+	"_ex",
+	"_ext",
+	"_extr",
+	"_f",
+	"_fo",
+	"_fon",
+	"_fonc",
+	"_fr",
+	"_fr\xc3",
+	"_fré",
+	"_g",
+	"_gr",
+	"_gra",
+	"_gram",
+	"_gran",
+	"_i",
+	"_id",
+	"_id\xc3",
+	"_idé",
+	"_l",
+	"_la",
+	"_la_",
+	"_la__",
+	"_lan",
+	"_lang",
+	"_le",
+	"_let",
+	"_lett",
+	"_li",
+	"_liv",
+	"_livr",
+	"_m",
+	"_mo",
+	"_moi",
+	"_moin",
+	"_mot",
+	"_moti",
+	"_m\xc3",
+	"_mê",
+	"_mêm",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_no",
+	"_nom",
+	"_nomb",
+	"_nou",
+	"_nouv",
+	"_o",
+	"_on",
+	"_ont",
+	"_ont_",
+	"_ou",
+	"_ou_",
+	"_ou__",
+	"_p",
+	"_pa",
+	"_pag",
+	"_page",
+	"_pas",
+	"_pass",
+	"_pe",
+	"_peu",
+	"_peut",
+	"_ph",
+	"_phr",
+	"_phra",
+	"_pl",
+	"_plu",
+	"_plus",
+	"_po",
+	"_pou",
+	"_pour",
+	"_pr",
+	"_pro",
+	"_prof",
+	"_q",
+	"_qu",
+	"_qui",
+	"_qui_",
+	"_quo",
+	"_quot",
+	"_r",
+	"_ra",
+	"_rai",
+	"_rais",
+	"_re",
+	"_rec",
+	"_rech",
+	"_r\xc3",
+	"_ré",
+	"_rép",
+	"_s",
+	"_s_",
+	"_s__",
+	"_s___",
+	"_se",
+	"_se_",
+	"_se__",
+	"_ses",
+	"_ses_",
+	"_si",
+	"_sim",
+	"_simp",
+	"_st",
+	"_sta",
+	"_stab",
+	"_su",
+	"_suf",
+	"_suff",
+	"_sur",
+	"_sur_",
+	"_s\xc3",
+	"_sé",
+	"_séq",
+	"_t",
+	"_ta",
+	"_tap",
+	"_tap\xc3",
+	"_te",
+	"_ten",
+	"_tend",
+	"_tex",
+	"_text",
+	"_tr",
+	"_tr\xc3",
+	"_trè",
+	"_u",
+	"_un",
+	"_un_",
+	"_un__",
+	"_une",
+	"_une_",
+	"_w",
+	"_we",
+	"_web",
+	"_web_",
+	"_\xc3",
+	"_à",
+	"_à_",
+	"_à__",
+	"_é",
+	"_éc",
+	"_éch",
+	"_écr",
+	"a",
+	"a_",
+	"a__",
+	"a___",
+	"a____",
+	"ab",
+	"abl",
+	"able",
+	"able_",
+	"ac",
+	"act",
+	"acte",
+	"acte_",
+	"ag",
+	"age",
+	"age_",
+	"age__",
+	"ai",
+	"ais",
+	"aiso",
+	"aison",
+	"ait",
+	"aits",
+	"aits_",
+	"al",
+	"aly",
+	"alys",
+	"alyse",
+	"am",
+	"amm",
+	"amme",
+	"ammen",
+	"ammes",
+	"an",
+	"ana",
+	"anal",
+	"analy",
+	"anc",
+	"ance",
+	"ance_",
+	"and",
+	"and_",
+	"and__",
+	"ang",
+	"angu",
+	"angue",
+	"ans",
+	"ans_",
+	"ans__",
+	"ant",
+	"ant_",
+	"ant__",
+	"anti",
+	"antil",
+	"ap",
+	"app",
+	"appe",
+	"appel",
+	"appu",
+	"appui",
+	"ap\xc3",
+	"apé",
+	"apée",
+	"ar",
+	"are",
+	"are_",
+	"are__",
+	"arer",
+	"arer_",
+	"arr",
+	"arre",
+	"arre_",
+	"as",
+	"ase",
+	"ase_",
+	"ase__",
+	"ass",
+	"assa",
+	"assag",
+	"assan",
+	"au",
+	"au_",
+	"au__",
+	"au___",
+	"av",
+	"ave",
+	"avec",
+	"avec_",
+	"b",
+	"b_",
+	"b__",
+	"b___",
+	"b____",
+	"ba",
+	"bar",
+	"barr",
+	"barre",
+	"bl",
+	"ble",
+	"ble_",
+	"ble__",
+	"bo",
+	"bon",
+	"bons",
+	"bons_",
+	"br",
+	"bre",
+	"breu",
+	"breus",
+	"c",
+	"c_",
+	"c__",
+	"c___",
+	"c____",
+	"ce",
+	"ce_",
+	"ce__",
+	"ce___",
+	"ces",
+	"ces_",
+	"ces__",
+	"cet",
+	"cett",
+	"cette",
+	"ch",
+	"cha",
+	"chan",
+	"chant",
+	"che",
+	"che_",
+	"che__",
+	"cher",
+	"cherc",
+	"cl",
+	"cla",
+	"clas",
+	"class",
+	"co",
+	"com",
+	"comp",
+	"compa",
+	"con",
+	"conf",
+	"confi",
+	"conn",
+	"connu",
+	"cons",
+	"const",
+	"cou",
+	"cour",
+	"court",
+	"cr",
+	"cri",
+	"crit",
+	"critu",
+	"ct",
+	"cte",
+	"cte_",
+	"cte__",
+	"cteu",
+	"cteur",
+	"cti",
+	"ctio",
+	"ction",
+	"d",
+	"d_",
+	"d__",
+	"d___",
+	"d____",
+	"da",
+	"dan",
+	"danc",
+	"dance",
+	"dans",
+	"dans_",
+	"de",
+	"de_",
+	"de__",
+	"de___",
+	"des",
+	"des_",
+	"des__",
+	"di",
+	"die",
+	"dien",
+	"dienn",
+	"do",
+	"don",
+	"donn",
+	"donn\xc3",
+	"du",
+	"du_",
+	"du__",
+	"du___",
+	"d\xc3",
+	"dé",
+	"dée",
+	"dée_",
+	"dét",
+	"déte",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"ea",
+	"eau",
+	"eau_",
+	"eau__",
+	"eb",
+	"eb_",
+	"eb__",
+	"eb___",
+	"ec",
+	"ec_",
+	"ec__",
+	"ec___",
+	"ech",
+	"eche",
+	"echer",
+	"ect",
+	"ecte",
+	"ecteu",
+	"ecti",
+	"ectio",
+	"ei",
+	"ein",
+	"eint",
+	"einte",
+	"el",
+	"el\xc3",
+	"elé",
+	"elée",
+	"em",
+	"emp",
+	"empr",
+	"empre",
+	"en",
+	"enc",
+	"ence",
+	"ence_",
+	"ences",
+	"end",
+	"enda",
+	"endan",
+	"enn",
+	"enne",
+	"enne_",
+	"ens",
+	"ensu",
+	"ensui",
+	"ent",
+	"ent_",
+	"ent__",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"erc",
+	"erch",
+	"erche",
+	"es",
+	"es_",
+	"es__",
+	"es___",
+	"et",
+	"et_",
+	"et__",
+	"et___",
+	"ett",
+	"ette",
+	"ette_",
+	"ettr",
+	"ettre",
+	"eu",
+	"eur",
+	"eur_",
+	"eur__",
+	"eurs",
+	"eurs_",
+	"eus",
+	"euse",
+	"euses",
+	"eut",
+	"eut_",
+	"eut__",
+	"ex",
+	"ext",
+	"exte",
+	"exte_",
+	"extr",
+	"extra",
+	"f",
+	"ff",
+	"ffi",
+	"ffis",
+	"ffisa",
+	"fi",
+	"fia",
+	"fian",
+	"fianc",
+	"fil",
+	"fils",
+	"fils_",
+	"fis",
+	"fisa",
+	"fisam",
+	"fo",
+	"fon",
+	"fonc",
+	"fonct",
+	"fr",
+	"fr\xc3",
+	"fré",
+	"fréq",
+	"fs",
+	"fs_",
+	"fs__",
+	"fs___",
+	"g",
+	"ge",
+	"ge_",
+	"ge__",
+	"ge___",
+	"gr",
+	"gra",
+	"gram",
+	"gramm",
+	"gran",
+	"grand",
+	"gu",
+	"gue",
+	"gue_",
+	"gue__",
+	"gues",
+	"gues_",
+	"h",
+	"ha",
+	"han",
+	"hant",
+	"hanti",
+	"he",
+	"he_",
+	"he__",
+	"he___",
+	"her",
+	"herc",
+	"herch",
+	"hr",
+	"hra",
+	"hras",
+	"hrase",
+	"i",
+	"i_",
+	"i__",
+	"i___",
+	"i____",
+	"ia",
+	"ian",
+	"ianc",
+	"iance",
+	"id",
+	"idi",
+	"idie",
+	"idien",
+	"id\xc3",
+	"idé",
+	"idée",
+	"ie",
+	"ien",
+	"ienn",
+	"ienne",
+	"ient",
+	"ient_",
+	"if",
+	"ifs",
+	"ifs_",
+	"ifs__",
+	"il",
+	"ill",
+	"illo",
+	"illon",
+	"ils",
+	"ils_",
+	"ils__",
+	"im",
+	"imp",
+	"impl",
+	"imple",
+	"in",
+	"ins",
+	"ins_",
+	"ins__",
+	"int",
+	"inte",
+	"inte_",
+	"io",
+	"ion",
+	"ion_",
+	"ion__",
+	"ionn",
+	"ionne",
+	"ir",
+	"ire",
+	"ire_",
+	"ire__",
+	"is",
+	"isa",
+	"isam",
+	"isamm",
+	"iso",
+	"ison",
+	"isonn",
+	"it",
+	"ite",
+	"ite_",
+	"ite__",
+	"its",
+	"its_",
+	"its__",
+	"itu",
+	"itur",
+	"iture",
+	"iv",
+	"ivr",
+	"ivre",
+	"ivre_",
+	"l",
+	"la",
+	"la_",
+	"la__",
+	"la___",
+	"lan",
+	"lang",
+	"langu",
+	"las",
+	"lass",
+	"lassa",
+	"le",
+	"le_",
+	"le__",
+	"le___",
+	"let",
+	"lett",
+	"lettr",
+	"li",
+	"liv",
+	"livr",
+	"livre",
+	"ll",
+	"llo",
+	"llon",
+	"llon_",
+	"lo",
+	"lon",
+	"lon_",
+	"lon__",
+	"ls",
+	"ls_",
+	"ls__",
+	"ls___",
+	"lu",
+	"lus",
+	"lus_",
+	"lus__",
+	"ly",
+	"lys",
+	"lyse",
+	"lyse_",
+	"l\xc3",
+	"lé",
+	"lée",
+	"lées",
+	"m",
+	"mb",
+	"mbr",
+	"mbre",
+	"mbreu",
+	"me",
+	"me_",
+	"me__",
+	"me___",
+	"men",
+	"ment",
+	"ment_",
+	"mes",
+	"mes_",
+	"mes__",
+	"mm",
+	"mme",
+	"mmen",
+	"mment",
+	"mmes",
+	"mmes_",
+	"mo",
+	"moi",
+	"moin",
+	"moins",
+	"mot",
+	"moti",
+	"motif",
+	"mp",
+	"mpa",
+	"mpac",
+	"mpact",
+	"mpar",
+	"mpare",
+	"mpl",
+	"mple",
+	"mple_",
+	"mpr",
+	"mpre",
+	"mprei",
+	"m\xc3",
+	"mê",
+	"mêm",
+	"même",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"nab",
+	"nabl",
+	"nable",
+	"nal",
+	"naly",
+	"nalys",
+	"nc",
+	"nce",
+	"nce_",
+	"nce__",
+	"nces",
+	"nces_",
+	"nct",
+	"ncti",
+	"nctio",
+	"nd",
+	"nd_",
+	"nd__",
+	"nd___",
+	"nda",
+	"ndan",
+	"ndanc",
+	"ne",
+	"ne_",
+	"ne__",
+	"ne___",
+	"nf",
+	"nfi",
+	"nfia",
+	"nfian",
+	"ng",
+	"ngu",
+	"ngue",
+	"ngue_",
+	"ngues",
+	"nn",
+	"nna",
+	"nnab",
+	"nnabl",
+	"nne",
+	"nne_",
+	"nne__",
+	"nnu",
+	"nnus",
+	"nnus_",
+	"nn\xc3",
+	"nné",
+	"nnée",
+	"no",
+	"nom",
+	"nomb",
+	"nombr",
+	"nou",
+	"nouv",
+	"nouve",
+	"ns",
+	"ns_",
+	"ns__",
+	"ns___",
+	"nst",
+	"nstr",
+	"nstru",
+	"nsu",
+	"nsui",
+	"nsuit",
+	"nt",
+	"nt_",
+	"nt__",
+	"nt___",
+	"nte",
+	"nte_",
+	"nte__",
+	"nti",
+	"ntil",
+	"ntill",
+	"nu",
+	"nus",
+	"nus_",
+	"nus__",
+	"n\xc3",
+	"né",
+	"née",
+	"née_",
+	"o",
+	"of",
+	"ofi",
+	"ofil",
+	"ofils",
+	"oi",
+	"oin",
+	"oins",
+	"oins_",
+	"om",
+	"omb",
+	"ombr",
+	"ombre",
+	"omp",
+	"ompa",
+	"ompac",
+	"ompar",
+	"on",
+	"on_",
+	"on__",
+	"on___",
+	"onc",
+	"onct",
+	"oncti",
+	"onf",
+	"onfi",
+	"onfia",
+	"onn",
+	"onna",
+	"onnab",
+	"onne",
+	"onne_",
+	"onnu",
+	"onnus",
+	"onn\xc3",
+	"onné",
+	"ons",
+	"ons_",
+	"ons__",
+	"onst",
+	"onstr",
+	"ont",
+	"ont_",
+	"ont__",
+	"ot",
+	"oti",
+	"otid",
+	"otidi",
+	"otif",
+	"otifs",
+	"ou",
+	"ou_",
+	"ou__",
+	"ou___",
+	"our",
+	"our_",
+	"our__",
+	"ourt",
+	"ourt_",
+	"ourte",
+	"ourts",
+	"ouv",
+	"ouve",
+	"ouvea",
+	"p",
+	"pa",
+	"pac",
+	"pact",
+	"pacte",
+	"pag",
+	"page",
+	"page_",
+	"par",
+	"pare",
+	"pare_",
+	"parer",
+	"pas",
+	"pass",
+	"passa",
+	"pe",
+	"pel",
+	"pel\xc3",
+	"pelé",
+	"peu",
+	"peut",
+	"peut_",
+	"ph",
+	"phr",
+	"phra",
+	"phras",
+	"pl",
+	"ple",
+	"ple_",
+	"ple__",
+	"plu",
+	"plus",
+	"plus_",
+	"po",
+	"pou",
+	"pour",
+	"pour_",
+	"pp",
+	"ppe",
+	"ppel",
+	"ppel\xc3",
+	"ppu",
+	"ppui",
+	"ppuie",
+	"pr",
+	"pre",
+	"prei",
+	"prein",
+	"pro",
+	"prof",
+	"profi",
+	"pu",
+	"pui",
+	"puie",
+	"puien",
+	"p\xc3",
+	"pé",
+	"pée",
+	"pée_",
+	"pét",
+	"péte",
+	"q",
+	"qu",
+	"que",
+	"quen",
+	"quenc",
+	"quent",
+	"qui",
+	"qui_",
+	"qui__",
+	"quo",
+	"quot",
+	"quoti",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"rai",
+	"rais",
+	"raiso",
+	"rait",
+	"raits",
+	"ram",
+	"ramm",
+	"ramme",
+	"ran",
+	"rand",
+	"rand_",
+	"ras",
+	"rase",
+	"rase_",
+	"rc",
+	"rch",
+	"rche",
+	"rche_",
+	"re",
+	"re_",
+	"re__",
+	"re___",
+	"rec",
+	"rech",
+	"reche",
+	"rei",
+	"rein",
+	"reint",
+	"rer",
+	"rer_",
+	"rer__",
+	"res",
+	"res_",
+	"res__",
+	"reu",
+	"reus",
+	"reuse",
+	"ri",
+	"rit",
+	"ritu",
+	"ritur",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"rr",
+	"rre",
+	"rre_",
+	"rre__",
+	"rs",
+	"rs_",
+	"rs__",
+	"rs___",
+	"rt",
+	"rt_",
+	"rt__",
+	"rt___",
+	"rte",
+	"rtes",
+	"rtes_",
+	"rts",
+	"rts_",
+	"rts__",
+	"ru",
+	"rui",
+	"ruir",
+	"ruire",
+	"r\xc3",
+	"rè",
+	"rès",
+	"rès_",
+	"ré",
+	"rép",
+	"rép\xc3",
+	"réq",
+	"réqu",
+	"s",
+	"s_",
+	"s__",
+	"s___",
+	"s____",
+	"sa",
+	"sag",
+	"sage",
+	"sage_",
+	"sam",
+	"samm",
+	"samme",
+	"san",
+	"sant",
+	"sant_",
+	"se",
+	"se_",
+	"se__",
+	"se___",
+	"ses",
+	"ses_",
+	"ses__",
+	"si",
+	"sim",
+	"simp",
+	"simpl",
+	"so",
+	"son",
+	"sonn",
+	"sonna",
+	"ss",
+	"ssa",
+	"ssag",
+	"ssage",
+	"ssan",
+	"ssant",
+	"st",
+	"sta",
+	"stab",
+	"stabl",
+	"str",
+	"stru",
+	"strui",
+	"su",
+	"suf",
+	"suff",
+	"suffi",
+	"sui",
+	"suit",
+	"suite",
+	"sur",
+	"sur_",
+	"sur__",
+	"s\xc3",
+	"sé",
+	"séq",
+	"séqu",
+	"t",
+	"t_",
+	"t__",
+	"t___",
+	"t____",
+	"ta",
+	"tab",
+	"tabl",
+	"table",
+	"tap",
+	"tap\xc3",
+	"tapé",
+	"te",
+	"te_",
+	"te__",
+	"te___",
+	"tec",
+	"tect",
+	"tecte",
+	"tecti",
+	"ten",
+	"tend",
+	"tenda",
+	"ter",
+	"ter_",
+	"ter__",
+	"tes",
+	"tes_",
+	"tes__",
+	"teu",
+	"teur",
+	"teur_",
+	"teurs",
+	"tex",
+	"text",
+	"texte",
+	"ti",
+	"tid",
+	"tidi",
+	"tidie",
+	"tif",
+	"tifs",
+	"tifs_",
+	"til",
+	"till",
+	"tillo",
+	"tio",
+	"tion",
+	"tion_",
+	"tionn",
+	"tr",
+	"tra",
+	"trai",
+	"trait",
+	"tre",
+	"tres",
+	"tres_",
+	"tru",
+	"trui",
+	"truir",
+	"tr\xc3",
+	"trè",
+	"très",
+	"ts",
+	"ts_",
+	"ts__",
+	"ts___",
+	"tt",
+	"tte",
+	"tte_",
+	"tte__",
+	"ttr",
+	"ttre",
+	"ttres",
+	"tu",
+	"tur",
+	"ture",
+	"ture_",
+	"u",
+	"u_",
+	"u__",
+	"u___",
+	"u____",
+	"ue",
+	"ue_",
+	"ue__",
+	"ue___",
+	"uen",
+	"uenc",
+	"uence",
+	"uent",
+	"uent_",
+	"ues",
+	"ues_",
+	"ues__",
+	"uf",
+	"uff",
+	"uffi",
+	"uffis",
+	"ui",
+	"ui_",
+	"ui__",
+	"ui___",
+	"uie",
+	"uien",
+	"uient",
+	"uir",
+	"uire",
+	"uire_",
+	"uit",
+	"uite",
+	"uite_",
+	"un",
+	"un_",
+	"un__",
+	"un___",
+	"une",
+	"une_",
+	"une__",
+	"uo",
+	"uot",
+	"uoti",
+	"uotid",
+	"ur",
+	"ur_",
+	"ur__",
+	"ur___",
+	"ure",
+	"ure_",
+	"ure__",
+	"urs",
+	"urs_",
+	"urs__",
+	"urt",
+	"urt_",
+	"urt__",
+	"urte",
+	"urtes",
+	"urts",
+	"urts_",
+	"us",
+	"us_",
+	"us__",
+	"us___",
+	"use",
+	"uses",
+	"uses_",
+	"ut",
+	"ut_",
+	"ut__",
+	"ut___",
+	"uv",
+	"uve",
+	"uvea",
+	"uveau",
+	"v",
+	"ve",
+	"vea",
+	"veau",
+	"veau_",
+	"vec",
+	"vec_",
+	"vec__",
+	"vr",
+	"vre",
+	"vre_",
+	"vre__",
+	"w",
+	"we",
+	"web",
+	"web_",
+	"web__",
+	"x",
+	"xt",
+	"xte",
+	"xte_",
+	"xte__",
+	"xtr",
+	"xtra",
+	"xtrai",
+	"y",
+	"ys",
+	"yse",
+	"yse_",
+	"yse__",
+	"\xa8",
+	"\xa8s",
+	"\xa8s_",
+	"\xa8s__",
+	"\xa8s___",
+	"\xa9",
+	"\xa9c",
+	"\xa9ch",
+	"\xa9cha",
+	"\xa9chan",
+	"\xa9cr",
+	"\xa9cri",
+	"\xa9crit",
+	"\xa9e",
+	"\xa9e_",
+	"\xa9e__",
+	"\xa9e___",
+	"\xa9es",
+	"\xa9es_",
+	"\xa9es__",
+	"\xa9p",
+	"\xa9p\xc3",
+	"\xa9pé",
+	"\xa9pét",
+	"\xa9q",
+	"\xa9qu",
+	"\xa9que",
+	"\xa9quen",
+	"\xa9t",
+	"\xa9te",
+	"\xa9tec",
+	"\xa9tect",
+	"\xa9ter",
+	"\xa9ter_",
+	"\xaa",
+	"\xaam",
+	"\xaame",
+	"\xaame_",
+	"\xaame__",
+	"\xc3",
+	"à",
+	"à_",
+	"à__",
+	"à___",
+	"è",
+	"ès",
+	"ès_",
+	"ès__",
+	"é",
+	"éc",
+	"éch",
+	"écha",
+	"écr",
+	"écri",
+	"ée",
+	"ée_",
+	"ée__",
+	"ées",
+	"ées_",
+	"ép",
+	"ép\xc3",
+	"épé",
+	"éq",
+	"équ",
+	"éque",
+	"ét",
+	"éte",
+	"étec",
+	"éter",
+	"ê",
+	"êm",
+	"ême",
+	"ême_",
+}
+
+var frenchRanks = []uint16{
+	1634,
+	1633,
+	1632,
+	1631,
+	1630,
+	388,
+	674,
+	673,
+	672,
+	671,
+	1629,
+	1628,
+	1627,
+	1626,
+	1625,
+	1624,
+	1623,
+	1622,
+	387,
+	670,
+	669,
+	668,
+	1621,
+	1620,
+	1619,
+	1618,
+	1617,
+	1616,
+	386,
+	667,
+	666,
+	1615,
+	1614,
+	1613,
+	1612,
+	385,
+	665,
+	1611,
+	1610,
+	384,
+	108,
+	664,
+	48,
+	26,
+	124,
+	229,
+	383,
+	1609,
+	100,
+	228,
+	227,
+	663,
+	70,
+	662,
+	382,
+	99,
+	159,
+	42,
+	1608,
+	226,
+	107,
+	1607,
+	661,
+	1606,
+	381,
+	660,
+	1605,
+	1604,
+	47,
+	659,
+	1603,
+	69,
+	25,
+	380,
+	658,
+	68,
+	1602,
+	1601,
+	379,
+	123,
+	657,
+	1600,
+	656,
+	1599,
+	225,
+	1598,
+	378,
+	377,
+	376,
+	1597,
+	1596,
+	98,
+	158,
+	655,
+	1595,
+	224,
+	654,
+	653,
+	223,
+	652,
+	651,
+	650,
+	1594,
+	1593,
+	67,
+	649,
+	1592,
+	1591,
+	1590,
+	375,
+	1589,
+	648,
+	647,
+	374,
+	1588,
+	1587,
+	1586,
+	97,
+	1585,
+	646,
+	1584,
+	1583,
+	645,
+	1582,
+	157,
+	1581,
+	373,
+	1580,
+	41,
+	40,
+	1579,
+	1578,
+	222,
+	644,
+	643,
+	106,
+	1577,
+	1576,
+	642,
+	641,
+	1575,
+	1574,
+	372,
+	371,
+	640,
+	1573,
+	1572,
+	1571,
+	1570,
+	46,
+	639,
+	1569,
+	1568,
+	1567,
+	1566,
+	66,
+	370,
+	369,
+	368,
+	24,
+	367,
+	366,
+	638,
+	637,
+	65,
+	96,
+	1565,
+	1564,
+	1563,
+	1562,
+	1561,
+	365,
+	364,
+	122,
+	636,
+	635,
+	1560,
+	1559,
+	634,
+	633,
+	1558,
+	1557,
+	221,
+	1556,
+	1555,
+	363,
+	362,
+	361,
+	360,
+	359,
+	1554,
+	1553,
+	1552,
+	95,
+	156,
+	1551,
+	220,
+	632,
+	631,
+	1550,
+	1549,
+	219,
+	630,
+	1548,
+	1547,
+	629,
+	628,
+	218,
+	627,
+	626,
+	625,
+	1546,
+	1545,
+	624,
+	1544,
+	1543,
+	1542,
+	1541,
+	64,
+	623,
+	1540,
+	1539,
+	1538,
+	1537,
+	1536,
+	1535,
+	1534,
+	1533,
+	358,
+	357,
+	1532,
+	1531,
+	622,
+	621,
+	1530,
+	1529,
+	356,
+	1528,
+	1527,
+	1526,
+	1525,
+	1524,
+	1523,
+	94,
+	1522,
+	1521,
+	620,
+	1520,
+	1519,
+	1518,
+	1517,
+	1516,
+	1515,
+	619,
+	1514,
+	1513,
+	1512,
+	1511,
+	155,
+	1510,
+	1509,
+	355,
+	1508,
+	618,
+	1507,
+	1506,
+	39,
+	38,
+	154,
+	93,
+	1505,
+	1504,
+	1503,
+	217,
+	617,
+	616,
+	615,
+	614,
+	105,
+	1502,
+	1501,
+	1500,
+	613,
+	612,
+	1499,
+	1498,
+	1497,
+	1496,
+	1495,
+	354,
+	353,
+	352,
+	611,
+	1494,
+	1493,
+	1492,
+	1491,
+	1490,
+	1489,
+	45,
+	610,
+	1488,
+	1487,
+	1486,
+	1485,
+	1484,
+	1483,
+	1482,
+	63,
+	351,
+	350,
+	349,
+	1481,
+	1480,
+	1479,
+	348,
+	347,
+	23,
+	346,
+	345,
+	344,
+	609,
+	608,
+	607,
+	62,
+	92,
+	91,
+	1478,
+	1477,
+	1476,
+	1475,
+	1474,
+	1473,
+	1472,
+	1471,
+	343,
+	342,
+	341,
+	121,
+	606,
+	605,
+	604,
+	1470,
+	1469,
+	1468,
+	603,
+	602,
+	601,
+	1467,
+	1466,
+	1465,
+	216,
+	1464,
+	1463,
+	1462,
+	340,
+	339,
+	338,
+	337,
+	336,
+	335,
+	600,
+	1461,
+	1460,
+	1459,
+	1458,
+	1457,
+	90,
+	153,
+	1456,
+	1455,
+	215,
+	214,
+	599,
+	598,
+	597,
+	1454,
+	1453,
+	1452,
+	213,
+	596,
+	1451,
+	1450,
+	1449,
+	1448,
+	595,
+	594,
+	593,
+	212,
+	592,
+	591,
+	590,
+	589,
+	1447,
+	1446,
+	1445,
+	1444,
+	588,
+	1443,
+	1442,
+	1441,
+	1440,
+	1439,
+	1438,
+	61,
+	587,
+	1437,
+	1436,
+	1435,
+	1434,
+	1433,
+	1432,
+	1431,
+	1430,
+	1429,
+	1428,
+	1427,
+	1426,
+	1425,
+	334,
+	333,
+	332,
+	1424,
+	1423,
+	1422,
+	586,
+	585,
+	1421,
+	1420,
+	1419,
+	1418,
+	331,
+	1417,
+	1416,
+	1415,
+	1414,
+	1413,
+	1412,
+	1411,
+	1410,
+	1409,
+	89,
+	1408,
+	1407,
+	1406,
+	584,
+	1405,
+	1404,
+	1403,
+	1402,
+	1401,
+	1400,
+	1399,
+	1398,
+	1397,
+	1396,
+	583,
+	1395,
+	1394,
+	1393,
+	1392,
+	1391,
+	1390,
+	1389,
+	152,
+	1388,
+	1387,
+	1386,
+	330,
+	1385,
+	1384,
+	582,
+	581,
+	1383,
+	1382,
+	1381,
+	37,
+	36,
+	151,
+	150,
+	88,
+	87,
+	1380,
+	1379,
+	1378,
+	1377,
+	211,
+	580,
+	579,
+	578,
+	577,
+	576,
+	1376,
+	1375,
+	10,
+	329,
+	328,
+	327,
+	326,
+	575,
+	574,
+	573,
+	572,
+	1374,
+	1373,
+	1372,
+	1371,
+	571,
+	570,
+	569,
+	568,
+	567,
+	1370,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	1364,
+	1363,
+	1362,
+	1361,
+	325,
+	324,
+	323,
+	1360,
+	566,
+	44,
+	1359,
+	1358,
+	1357,
+	565,
+	564,
+	563,
+	1356,
+	1355,
+	1354,
+	210,
+	209,
+	208,
+	562,
+	561,
+	560,
+	559,
+	1353,
+	1352,
+	1351,
+	1350,
+	322,
+	558,
+	1349,
+	1348,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	321,
+	557,
+	1342,
+	1341,
+	1340,
+	1339,
+	1338,
+	1337,
+	1336,
+	320,
+	1335,
+	1334,
+	1333,
+	556,
+	555,
+	1332,
+	1331,
+	554,
+	553,
+	552,
+	551,
+	319,
+	318,
+	317,
+	316,
+	120,
+	1330,
+	1329,
+	1328,
+	1327,
+	1326,
+	1325,
+	1324,
+	1323,
+	550,
+	549,
+	548,
+	547,
+	1322,
+	1321,
+	1320,
+	1319,
+	1318,
+	1317,
+	1316,
+	1315,
+	12,
+	315,
+	314,
+	313,
+	312,
+	119,
+	311,
+	310,
+	309,
+	546,
+	545,
+	544,
+	1314,
+	1313,
+	1312,
+	308,
+	1311,
+	1310,
+	1309,
+	543,
+	1308,
+	1307,
+	1306,
+	1305,
+	1304,
+	1303,
+	1302,
+	1301,
+	60,
+	307,
+	306,
+	305,
+	304,
+	1300,
+	1299,
+	1298,
+	1297,
+	1296,
+	1295,
+	303,
+	302,
+	301,
+	1294,
+	1293,
+	1292,
+	1291,
+	149,
+	300,
+	1290,
+	1289,
+	542,
+	541,
+	540,
+	539,
+	538,
+	14,
+	207,
+	206,
+	205,
+	204,
+	299,
+	298,
+	1288,
+	1287,
+	537,
+	536,
+	59,
+	86,
+	85,
+	84,
+	1286,
+	1285,
+	1284,
+	1283,
+	1282,
+	1281,
+	1280,
+	1279,
+	1278,
+	1277,
+	1276,
+	1275,
+	1274,
+	1273,
+	1272,
+	203,
+	202,
+	1271,
+	1270,
+	297,
+	296,
+	1,
+	8,
+	7,
+	6,
+	5,
+	1269,
+	1268,
+	1267,
+	1266,
+	1265,
+	1264,
+	1263,
+	1262,
+	104,
+	295,
+	294,
+	293,
+	1261,
+	1260,
+	1259,
+	292,
+	535,
+	534,
+	1258,
+	1257,
+	533,
+	532,
+	531,
+	530,
+	1256,
+	1255,
+	1254,
+	1253,
+	529,
+	528,
+	527,
+	526,
+	58,
+	525,
+	524,
+	1252,
+	1251,
+	1250,
+	1249,
+	1248,
+	1247,
+	1246,
+	1245,
+	1244,
+	1243,
+	1242,
+	201,
+	200,
+	199,
+	523,
+	1241,
+	1240,
+	1239,
+	1238,
+	1237,
+	1236,
+	35,
+	34,
+	33,
+	32,
+	148,
+	522,
+	521,
+	520,
+	291,
+	1235,
+	1234,
+	519,
+	518,
+	198,
+	517,
+	1233,
+	1232,
+	1231,
+	1230,
+	1229,
+	1228,
+	1227,
+	1226,
+	1225,
+	1224,
+	290,
+	289,
+	516,
+	515,
+	1223,
+	1222,
+	57,
+	1221,
+	1220,
+	1219,
+	1218,
+	288,
+	1217,
+	1216,
+	1215,
+	1214,
+	1213,
+	1212,
+	1211,
+	1210,
+	1209,
+	1208,
+	1207,
+	1206,
+	1205,
+	287,
+	286,
+	285,
+	284,
+	1204,
+	1203,
+	1202,
+	1201,
+	56,
+	514,
+	513,
+	512,
+	511,
+	283,
+	282,
+	510,
+	509,
+	1200,
+	1199,
+	197,
+	196,
+	281,
+	280,
+	1198,
+	1197,
+	195,
+	1196,
+	1195,
+	1194,
+	1193,
+	508,
+	1192,
+	1191,
+	1190,
+	1189,
+	1188,
+	1187,
+	1186,
+	1185,
+	1184,
+	1183,
+	19,
+	1182,
+	1181,
+	1180,
+	1179,
+	1178,
+	1177,
+	1176,
+	1175,
+	507,
+	1174,
+	1173,
+	1172,
+	1171,
+	1170,
+	1169,
+	506,
+	505,
+	1168,
+	1167,
+	1166,
+	1165,
+	1164,
+	1163,
+	1162,
+	1161,
+	504,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	1155,
+	1154,
+	1153,
+	1152,
+	1151,
+	279,
+	1150,
+	1149,
+	1148,
+	503,
+	502,
+	501,
+	500,
+	499,
+	1147,
+	1146,
+	1145,
+	1144,
+	1143,
+	1142,
+	1141,
+	1140,
+	498,
+	1139,
+	1138,
+	1137,
+	1136,
+	1135,
+	1134,
+	278,
+	1133,
+	1132,
+	1131,
+	1130,
+	1129,
+	1128,
+	1127,
+	1126,
+	1125,
+	1124,
+	1123,
+	1122,
+	1121,
+	27,
+	118,
+	1120,
+	1119,
+	1118,
+	194,
+	193,
+	192,
+	1117,
+	1116,
+	1115,
+	147,
+	277,
+	276,
+	275,
+	497,
+	496,
+	495,
+	1114,
+	1113,
+	1112,
+	1111,
+	1110,
+	1109,
+	1108,
+	1107,
+	1106,
+	1105,
+	1104,
+	1103,
+	1102,
+	1101,
+	1100,
+	1099,
+	1098,
+	1097,
+	1096,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	1088,
+	1087,
+	22,
+	1086,
+	1085,
+	1084,
+	1083,
+	146,
+	494,
+	493,
+	492,
+	1082,
+	1081,
+	1080,
+	491,
+	490,
+	489,
+	274,
+	273,
+	1079,
+	1078,
+	488,
+	487,
+	486,
+	1077,
+	1076,
+	1075,
+	1074,
+	1073,
+	1072,
+	117,
+	272,
+	1071,
+	1070,
+	485,
+	484,
+	1069,
+	1068,
+	1067,
+	483,
+	482,
+	481,
+	480,
+	479,
+	478,
+	477,
+	2,
+	83,
+	82,
+	81,
+	80,
+	476,
+	1066,
+	1065,
+	1064,
+	1063,
+	1062,
+	1061,
+	145,
+	191,
+	271,
+	270,
+	1060,
+	1059,
+	1058,
+	1057,
+	1056,
+	475,
+	1055,
+	1054,
+	1053,
+	1052,
+	1051,
+	1050,
+	54,
+	53,
+	52,
+	51,
+	1049,
+	1048,
+	1047,
+	1046,
+	190,
+	189,
+	188,
+	269,
+	1045,
+	144,
+	1044,
+	1043,
+	1042,
+	474,
+	473,
+	472,
+	1041,
+	1040,
+	1039,
+	1038,
+	1037,
+	1036,
+	471,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	1030,
+	116,
+	187,
+	186,
+	185,
+	1029,
+	1028,
+	1027,
+	1026,
+	1025,
+	1024,
+	55,
+	115,
+	114,
+	113,
+	470,
+	469,
+	468,
+	1023,
+	1022,
+	1021,
+	1020,
+	1019,
+	1018,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	13,
+	1012,
+	1011,
+	1010,
+	1009,
+	1008,
+	1007,
+	1006,
+	1005,
+	184,
+	1004,
+	1003,
+	1002,
+	268,
+	267,
+	1001,
+	467,
+	49,
+	466,
+	465,
+	464,
+	1000,
+	999,
+	998,
+	997,
+	996,
+	995,
+	183,
+	994,
+	993,
+	992,
+	991,
+	990,
+	989,
+	988,
+	987,
+	463,
+	986,
+	985,
+	984,
+	983,
+	982,
+	981,
+	980,
+	462,
+	461,
+	979,
+	978,
+	977,
+	976,
+	79,
+	975,
+	974,
+	973,
+	112,
+	266,
+	265,
+	264,
+	972,
+	971,
+	970,
+	969,
+	968,
+	967,
+	20,
+	143,
+	966,
+	965,
+	964,
+	963,
+	962,
+	961,
+	460,
+	459,
+	960,
+	959,
+	958,
+	957,
+	956,
+	458,
+	955,
+	954,
+	953,
+	952,
+	951,
+	950,
+	949,
+	948,
+	947,
+	946,
+	457,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	263,
+	262,
+	261,
+	260,
+	456,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	259,
+	455,
+	454,
+	453,
+	933,
+	932,
+	931,
+	930,
+	929,
+	928,
+	927,
+	452,
+	451,
+	926,
+	925,
+	924,
+	923,
+	111,
+	110,
+	182,
+	181,
+	450,
+	449,
+	922,
+	921,
+	920,
+	919,
+	918,
+	917,
+	11,
+	142,
+	141,
+	140,
+	139,
+	109,
+	448,
+	916,
+	915,
+	914,
+	913,
+	447,
+	446,
+	445,
+	912,
+	911,
+	910,
+	909,
+	908,
+	907,
+	906,
+	905,
+	904,
+	903,
+	43,
+	138,
+	137,
+	136,
+	902,
+	901,
+	900,
+	444,
+	443,
+	442,
+	899,
+	898,
+	897,
+	441,
+	440,
+	439,
+	896,
+	895,
+	894,
+	893,
+	892,
+	891,
+	890,
+	889,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	258,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	135,
+	864,
+	863,
+	862,
+	180,
+	861,
+	860,
+	257,
+	256,
+	9,
+	18,
+	17,
+	16,
+	15,
+	255,
+	859,
+	858,
+	857,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	134,
+	254,
+	253,
+	252,
+	438,
+	437,
+	436,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	844,
+	843,
+	435,
+	434,
+	842,
+	841,
+	840,
+	839,
+	433,
+	838,
+	837,
+	836,
+	835,
+	834,
+	833,
+	251,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	826,
+	825,
+	824,
+	823,
+	822,
+	821,
+	820,
+	4,
+	78,
+	77,
+	76,
+	75,
+	432,
+	819,
+	818,
+	817,
+	816,
+	815,
+	814,
+	28,
+	103,
+	102,
+	101,
+	250,
+	249,
+	431,
+	813,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	430,
+	429,
+	803,
+	802,
+	428,
+	427,
+	426,
+	133,
+	801,
+	800,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	425,
+	424,
+	792,
+	791,
+	132,
+	790,
+	789,
+	788,
+	423,
+	422,
+	421,
+	787,
+	786,
+	785,
+	784,
+	783,
+	782,
+	420,
+	419,
+	418,
+	417,
+	248,
+	781,
+	780,
+	779,
+	416,
+	415,
+	414,
+	778,
+	777,
+	776,
+	775,
+	3,
+	179,
+	178,
+	177,
+	176,
+	74,
+	247,
+	246,
+	245,
+	175,
+	413,
+	412,
+	411,
+	410,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	174,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	757,
+	756,
+	31,
+	131,
+	130,
+	129,
+	73,
+	72,
+	71,
+	755,
+	754,
+	753,
+	752,
+	50,
+	128,
+	127,
+	126,
+	751,
+	750,
+	749,
+	748,
+	747,
+	746,
+	244,
+	745,
+	744,
+	743,
+	742,
+	741,
+	740,
+	243,
+	409,
+	408,
+	407,
+	739,
+	738,
+	737,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	125,
+	173,
+	728,
+	727,
+	726,
+	242,
+	241,
+	240,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	717,
+	239,
+	238,
+	406,
+	405,
+	404,
+	716,
+	715,
+	714,
+	713,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	706,
+	705,
+	704,
+	30,
+	403,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	172,
+	237,
+	236,
+	235,
+	697,
+	696,
+	695,
+	694,
+	693,
+	692,
+	691,
+	171,
+	170,
+	169,
+	168,
+	167,
+	166,
+	234,
+	233,
+	690,
+	689,
+	402,
+	401,
+	400,
+	399,
+	398,
+	21,
+	397,
+	396,
+	395,
+	394,
+	688,
+	687,
+	686,
+	685,
+	29,
+	393,
+	684,
+	683,
+	682,
+	681,
+	165,
+	232,
+	231,
+	680,
+	679,
+	678,
+	677,
+	676,
+	164,
+	163,
+	162,
+	161,
+	160,
+	230,
+	675,
+	392,
+	391,
+	390,
+	389,
+}
+
+var germanTokens = []string{
+	"B",
+	"Bu",
+	"Buc",
+	"Buch",
+	"Buch_",
+	"Buchs",
+	"D",
+	"Da",
+	"Das",
+	"Das_",
+	"Das__",
+	"Di",
+	"Die",
+	"Die_",
+	"Die__",
+	"Du",
+	"Dur",
+	"Durc",
+	"Durch",
+	"E",
+	"Er",
+	"Erk",
+	"Erke",
+	"Erken",
+	"F",
+	"Fi",
+	"Fin",
+	"Fing",
+	"Finge",
+	"G",
+	"Gr",
+	"Gra",
+	"Gram",
+	"Gramm",
+	"Gu",
+	"Gut",
+	"Gute",
+	"Gute_",
+	"H",
+	"H\xc3",
+	"Hä",
+	"Häu",
+	"Häuf",
+	"N",
+	"N_",
+	"N__",
+	"N___",
+	"N____",
+	"P",
+	"Pr",
+	"Pro",
+	"Prof",
+	"Profi",
+	"R",
+	"Ra",
+	"Ran",
+	"Rang",
+	"Rangf",
+	"S",
+	"Sa",
+	"Sat",
+	"Satz",
+	"Satz_",
+	"Si",
+	"Sic",
+	"Sich",
+	"Siche",
+	"Sp",
+	"Spr",
+	"Spra",
+	"Sprac",
+	"Su",
+	"Suc",
+	"Such",
+	"Suchl",
+	"Sy",
+	"Sys",
+	"Syst",
+	"Syste",
+	"T",
+	"Te",
+	"Tex",
+	"Text",
+	"Text_",
+	"Texta",
+	"Textb",
+	"W",
+	"We",
+	"Web",
+	"Webs",
+	"Webse",
+	"_B",
+	"_Bu",
+	"_Buc",
+	"_Buch",
+	"_D",
+	"_Da",
+	"_Das",
+	"_Das_",
+	"_Di",
+	"_Die",
+	"_Die_",
+	"_Du",
+	"_Dur",
+	"_Durc",
+	"_E",
+	"_Er",
+	"_Erk",
+	"_Erke",
+	"_F",
+	"_Fi",
+	"_Fin",
+	"_Fing",
+	"_G",
+	"_Gr",
+	"_Gra",
+	"_Gram",
+	"_Gu",
+	"_Gut",
+	"_Gute",
+	"_H",
+	"_H\xc3",
+	"_Hä",
+	"_Häu",
+	"_N",
+	"_N_",
+	"_N__",
+	"_N___",
+	"_P",
+	"_Pr",
+	"_Pro",
+	"_Prof",
+	"_R",
+	"_Ra",
+	"_Ran",
+	"_Rang",
+	"_S",
+	"_Sa",
+	"_Sat",
+	"_Satz",
+	"_Si",
+	"_Sic",
+	"_Sich",
+	"_Sp",
+	"_Spr",
+	"_Spra",
+	"_Su",
+	"_Suc",
+	"_Such",
+	"_Sy",
+	"_Sys",
+	"_Syst",
+	"_T",
+	"_Te",
+	"_Tex",
+	"_Text",
+	"_W",
+	"_We",
+	"_Web",
+	"_Webs",
+	"__B",
+	"__Bu",
+	"__Buc",
+	"__D",
+	"__Da",
+	"__Das",
+	"__Di",
+	"__Die",
+	"__Du",
+	"__Dur",
+	"__E",
+	"__Er",
+	"__Erk",
+	"__F",
+	"__Fi",
+	"__Fin",
+	"__G",
+	"__Gr",
+	"__Gra",
+	"__Gu",
+	"__Gut",
+	"__H",
+	"__H\xc3",
+	"__Hä",
+	"__N",
+	"__N_",
+	"__N__",
+	"__P",
+	"__Pr",
+	"__Pro",
+	"__R",
+	"__Ra",
+	"__Ran",
+	"__S",
+	"__Sa",
+	"__Sat",
+	"__Si",
+	"__Sic",
+	"__Sp",
+	"__Spr",
+	"__Su",
+	"__Suc",
+	"__Sy",
+	"__Sys",
+	"__T",
+	"__Te",
+	"__Tex",
+	"__W",
+	"__We",
+	"__Web",
+	"___B",
+	"___Bu",
+	"___D",
+	"___Da",
+	"___Di",
+	"___Du",
+	"___E",
+	"___Er",
+	"___F",
+	"___Fi",
+	"___G",
+	"___Gr",
+	"___Gu",
+	"___H",
+	"___H\xc3",
+	"___N",
+	"___N_",
+	"___P",
+	"___Pr",
+	"___R",
+	"___Ra",
+	"___S",
+	"___Sa",
+	"___Si",
+	"___Sp",
+	"___Su",
+	"___Sy",
+	"___T",
+	"___Te",
+	"___W",
+	"___We",
+	"____B",
+	"____D",
+	"____E",
+	"____F",
+	"____G",
+	"____H",
+	"____N",
+	"____P",
+	"____R",
+	"____S",
+	"____T",
+	"____W",
+	"____a",
+	"____b",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____h",
+	"____i",
+	"____j",
+	"____k",
+	"____m",
+	"____n",
+	"____o",
+	"____s",
+	"____u",
+	"____v",
+	"____w",
+	"____z",
+	"___a",
+	"___al",
+	"___an",
+	"___au",
+	"___b",
+	"___be",
+	"___d",
+	"___di",
+	"___e",
+	"___ei",
+	"___er",
+	"___f",
+	"___fu",
+	"___g",
+	"___gl",
+	"___gr",
+	"___gu",
+	"___h",
+	"___h\xc3",
+	"___i",
+	"___in",
+	"___j",
+	"___je",
+	"___k",
+	"___ka",
+	"___ko",
+	"___ku",
+	"___m",
+	"___mi",
+	"___n",
+	"___ne",
+	"___nu",
+	"___o",
+	"___od",
+	"___s",
+	"___se",
+	"___so",
+	"___sp",
+	"___st",
+	"___u",
+	"___un",
+	"___v",
+	"___ve",
+	"___vi",
+	"___vo",
+	"___w",
+	"___wi",
+	"___z",
+	"___zu",
+	"__a",
+	"__al",
+	"__all",
+	"__an",
+	"__ang",
+	"__au",
+	"__aus",
+	"__b",
+	"__be",
+	"__bei",
+	"__bek",
+	"__d",
+	"__di",
+	"__die",
+	"__e",
+	"__ei",
+	"__ein",
+	"__er",
+	"__ers",
+	"__f",
+	"__fu",
+	"__fun",
+	"__g",
+	"__gl",
+	"__gle",
+	"__gr",
+	"__gro",
+	"__gu",
+	"__gut",
+	"__h",
+	"__h\xc3",
+	"__hä",
+	"__i",
+	"__in",
+	"__in_",
+	"__j",
+	"__je",
+	"__jed",
+	"__k",
+	"__ka",
+	"__kan",
+	"__ko",
+	"__kom",
+	"__ku",
+	"__kur",
+	"__m",
+	"__mi",
+	"__mit",
+	"__n",
+	"__ne",
+	"__neu",
+	"__nu",
+	"__nut",
+	"__o",
+	"__od",
+	"__ode",
+	"__s",
+	"__se",
+	"__seh",
+	"__sei",
+	"__sel",
+	"__so",
+	"__sog",
+	"__sp",
+	"__sp\xc3",
+	"__st",
+	"__sta",
+	"__u",
+	"__un",
+	"__und",
+	"__unt",
+	"__v",
+	"__ve",
+	"__ver",
+	"__vi",
+	"__vie",
+	"__vo",
+	"__von",
+	"__w",
+	"__wi",
+	"__wie",
+	"__z",
+	"__zu",
+	"__zu_",
+	"_a",
+	"_al",
+	"_all",
+	"_allt",
+	"_an",
+	"_ang",
+	"_ange",
+	"_au",
+	"_aus",
+	"_ausr",
+	"_b",
+	"_be",
+	"_bei",
+	"_bei_",
+	"_bek",
+	"_beka",
+	"_d",
+	"_di",
+	"_die",
+	"_die_",
+	"_dies",
+	"_e",
+	"_ei",
+	"_ein",
+	"_ein_",
+	"_eine",
+	"_einz",
+	"_er",
+	"_ers",
+	"_erst",
+	"_f",
+	"_fu",
+	"_fun",
+	"_funk",
+	"_g",
+	"_gl",
+	"_gle",
+	"_glei",
+	"_gr",
+	"_gro",
+	"_gro\xc3",
+	"_gu",
+	"_gut",
+	"_gut_",
+	"_h",
+	"_h\xc3",
+	"_hä",
+	"_häu",
+	"_i",
+	"_in",
+	"_in_",
+	"_in__",
+	"_j",
+	"_je",
+	"_jed",
+	"_jede",
+	"_k",
+	"_ka",
+	"_kan",
+	"_kann",
+	"_ko",
+	"_kom",
+	"_komp",
+	"_ku",
+	"_kur",
+	"_kurz",
+	"_m",
+	"_mi",
+	"_mit",
+	"_mit_",
+	"_n",
+	"_ne",
+	"_neu",
+	"_neue",
+	"_nu",
+	"_nut",
+	"_nutz",
+	"_o",
+	"_od",
+	"_ode",
+	"_oder",
+	"_s",
+	"_se",
+	"_seh",
+	"_sehr",
+	"_sei",
+	"_sein",
+	"_sel",
+	"_selb",
+	"_selt",
+	"_so",
+	"_sog",
+	"_soge",
+	"_sp",
+	"_sp\xc3",
+	"_spä",
+	"_st",
+	"_sta",
+	"_stab",
+	"_u",
+	"_un",
+	"_und",
+	"_und_",
+	"_unt",
+	"_unte",
+	"_v",
+	"_ve",
+	"_ver",
+	"_verg",
+	"_vi",
+	"_vie",
+	"_viel",
+	"_vo",
+	"_von",
+	"_von_",
+	"_w",
+	"_wi",
+	"_wie",
+	"_wied",
+	"_z",
+	"_zu",
+	"_zu_",
+	"_zu__",
+	"a",
+	"ab",
+	"abd",
+	"abdr",
+	"abdru",
+	"abe",
+	"aben",
+	"abenf",
+	"abenm",
+	"abi",
+	"abil",
+	"abile",
+	"abs",
+	"absc",
+	"absch",
+	"ac",
+	"ach",
+	"ache",
+	"ache_",
+	"achen",
+	"acher",
+	"ak",
+	"akt",
+	"akte",
+	"akten",
+	"al",
+	"all",
+	"allt",
+	"allt\xc3",
+	"am",
+	"amm",
+	"amme",
+	"amme_",
+	"an",
+	"ang",
+	"ange",
+	"angem",
+	"angf",
+	"angfo",
+	"ann",
+	"ann_",
+	"ann__",
+	"annt",
+	"annte",
+	"as",
+	"as_",
+	"as__",
+	"as___",
+	"at",
+	"atz",
+	"atz_",
+	"atz__",
+	"au",
+	"aus",
+	"ausr",
+	"ausre",
+	"auss",
+	"aussc",
+	"b",
+	"bd",
+	"bdr",
+	"bdru",
+	"bdruc",
+	"be",
+	"bei",
+	"bei_",
+	"bei__",
+	"beis",
+	"beisp",
+	"bek",
+	"beka",
+	"bekan",
+	"ben",
+	"benf",
+	"benfo",
+	"benm",
+	"benmu",
+	"bi",
+	"bil",
+	"bile",
+	"biler",
+	"bs",
+	"bsc",
+	"bsch",
+	"bschn",
+	"bse",
+	"bsei",
+	"bseit",
+	"bst",
+	"bst_",
+	"bst__",
+	"c",
+	"ch",
+	"ch_",
+	"ch__",
+	"ch___",
+	"che",
+	"che_",
+	"che__",
+	"chen",
+	"chen_",
+	"chend",
+	"cher",
+	"cherh",
+	"cherk",
+	"chl",
+	"chle",
+	"chlei",
+	"chn",
+	"chni",
+	"chnit",
+	"chs",
+	"chst",
+	"chsta",
+	"cht",
+	"cht_",
+	"cht__",
+	"ck",
+	"ck_",
+	"ck__",
+	"ck___",
+	"d",
+	"d_",
+	"d__",
+	"d___",
+	"d____",
+	"de",
+	"dem",
+	"dem_",
+	"dem__",
+	"der",
+	"der_",
+	"der__",
+	"derk",
+	"derke",
+	"di",
+	"die",
+	"die_",
+	"die__",
+	"dies",
+	"diese",
+	"dr",
+	"dru",
+	"druc",
+	"druck",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"eb",
+	"ebs",
+	"ebse",
+	"ebsei",
+	"ed",
+	"ede",
+	"edem",
+	"edem_",
+	"eder",
+	"ederk",
+	"eh",
+	"ehr",
+	"ehr_",
+	"ehr__",
+	"ehre",
+	"ehren",
+	"ei",
+	"ei_",
+	"ei__",
+	"ei___",
+	"eic",
+	"eich",
+	"eich_",
+	"eiche",
+	"eicht",
+	"ein",
+	"ein_",
+	"ein__",
+	"eine",
+	"eine_",
+	"einem",
+	"einen",
+	"einer",
+	"einz",
+	"einze",
+	"eis",
+	"eisp",
+	"eispi",
+	"eist",
+	"eiste",
+	"eit",
+	"eit_",
+	"eit__",
+	"eite",
+	"eite_",
+	"ek",
+	"eka",
+	"ekan",
+	"ekann",
+	"el",
+	"el_",
+	"el__",
+	"el___",
+	"elb",
+	"elbs",
+	"elbst",
+	"ele",
+	"eler",
+	"eler_",
+	"ell",
+	"elle",
+	"ellen",
+	"eln",
+	"elne",
+	"elnen",
+	"elt",
+	"elte",
+	"elten",
+	"em",
+	"em_",
+	"em__",
+	"em___",
+	"eme",
+	"eme_",
+	"eme__",
+	"emes",
+	"emess",
+	"en",
+	"en_",
+	"en__",
+	"en___",
+	"ena",
+	"enan",
+	"enann",
+	"end",
+	"end_",
+	"end__",
+	"ene",
+	"ener",
+	"ener_",
+	"enf",
+	"enfo",
+	"enfol",
+	"enm",
+	"enmu",
+	"enmus",
+	"enn",
+	"ennu",
+	"ennun",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"era",
+	"erab",
+	"erabd",
+	"erg",
+	"ergl",
+	"ergle",
+	"erh",
+	"erhe",
+	"erhei",
+	"erk",
+	"erke",
+	"erkeh",
+	"erken",
+	"ers",
+	"erst",
+	"erste",
+	"ersu",
+	"ersuc",
+	"ert",
+	"ert_",
+	"ert__",
+	"es",
+	"ese",
+	"eser",
+	"eser_",
+	"ess",
+	"esse",
+	"essen",
+	"eu",
+	"eue",
+	"euen",
+	"euen_",
+	"ex",
+	"ext",
+	"ext_",
+	"ext__",
+	"exta",
+	"extab",
+	"extau",
+	"extb",
+	"extbe",
+	"f",
+	"fi",
+	"fig",
+	"fig_",
+	"fig__",
+	"figk",
+	"figke",
+	"fil",
+	"file",
+	"filen",
+	"fo",
+	"fol",
+	"folg",
+	"folge",
+	"fu",
+	"fun",
+	"funk",
+	"funkt",
+	"g",
+	"g_",
+	"g__",
+	"g___",
+	"g____",
+	"ge",
+	"ge_",
+	"ge__",
+	"ge___",
+	"gem",
+	"geme",
+	"gemes",
+	"gen",
+	"gen_",
+	"gen__",
+	"gena",
+	"genan",
+	"ger",
+	"gera",
+	"gerab",
+	"gf",
+	"gfo",
+	"gfol",
+	"gfolg",
+	"gk",
+	"gke",
+	"gkei",
+	"gkeit",
+	"gl",
+	"gle",
+	"glei",
+	"gleic",
+	"gli",
+	"glic",
+	"glich",
+	"gr",
+	"gro",
+	"gro\xc3",
+	"groß",
+	"gs",
+	"gss",
+	"gssy",
+	"gssys",
+	"gu",
+	"gut",
+	"gut_",
+	"gut__",
+	"h",
+	"h_",
+	"h__",
+	"h___",
+	"h____",
+	"he",
+	"he_",
+	"he__",
+	"he___",
+	"hei",
+	"heit",
+	"heit_",
+	"hen",
+	"hen_",
+	"hen__",
+	"hend",
+	"hend_",
+	"her",
+	"herh",
+	"herhe",
+	"herk",
+	"herke",
+	"hl",
+	"hle",
+	"hlei",
+	"hleis",
+	"hn",
+	"hni",
+	"hnit",
+	"hnitt",
+	"hr",
+	"hr_",
+	"hr__",
+	"hr___",
+	"hre",
+	"hren",
+	"hren_",
+	"hs",
+	"hst",
+	"hsta",
+	"hstab",
+	"ht",
+	"ht_",
+	"ht__",
+	"ht___",
+	"h\xc3",
+	"hä",
+	"häu",
+	"häuf",
+	"i",
+	"i_",
+	"i__",
+	"i___",
+	"i____",
+	"ic",
+	"ich",
+	"ich_",
+	"ich__",
+	"iche",
+	"ichen",
+	"icher",
+	"icht",
+	"icht_",
+	"ie",
+	"ie_",
+	"ie__",
+	"ie___",
+	"ied",
+	"iede",
+	"ieder",
+	"iel",
+	"iel_",
+	"iel__",
+	"iele",
+	"ieler",
+	"ier",
+	"iert",
+	"iert_",
+	"ies",
+	"iese",
+	"ieser",
+	"ig",
+	"ig_",
+	"ig__",
+	"ig___",
+	"igk",
+	"igke",
+	"igkei",
+	"il",
+	"ile",
+	"ilen",
+	"ilen_",
+	"iler",
+	"iler_",
+	"in",
+	"in_",
+	"in__",
+	"in___",
+	"ine",
+	"ine_",
+	"ine__",
+	"inem",
+	"inem_",
+	"inen",
+	"inen_",
+	"iner",
+	"iner_",
+	"ing",
+	"inge",
+	"inger",
+	"inz",
+	"inze",
+	"inzel",
+	"io",
+	"ion",
+	"ioni",
+	"ionie",
+	"is",
+	"isp",
+	"ispi",
+	"ispie",
+	"ist",
+	"iste",
+	"iste_",
+	"it",
+	"it_",
+	"it__",
+	"it___",
+	"ite",
+	"ite_",
+	"ite__",
+	"itt",
+	"itt_",
+	"itt__",
+	"itte",
+	"itten",
+	"j",
+	"je",
+	"jed",
+	"jede",
+	"jedem",
+	"k",
+	"k_",
+	"k__",
+	"k___",
+	"k____",
+	"ka",
+	"kan",
+	"kann",
+	"kann_",
+	"kannt",
+	"ke",
+	"keh",
+	"kehr",
+	"kehre",
+	"kei",
+	"keit",
+	"keit_",
+	"ken",
+	"kenn",
+	"kennu",
+	"ko",
+	"kom",
+	"komp",
+	"kompa",
+	"kt",
+	"kte",
+	"kten",
+	"kten_",
+	"kti",
+	"ktio",
+	"ktion",
+	"ku",
+	"kur",
+	"kurz",
+	"kurze",
+	"l",
+	"l_",
+	"l__",
+	"l___",
+	"l____",
+	"lb",
+	"lbs",
+	"lbst",
+	"lbst_",
+	"le",
+	"lei",
+	"leic",
+	"leich",
+	"leis",
+	"leist",
+	"len",
+	"len_",
+	"len__",
+	"ler",
+	"ler_",
+	"ler__",
+	"lg",
+	"lge",
+	"lge_",
+	"lge__",
+	"lgen",
+	"lgen_",
+	"li",
+	"lic",
+	"lich",
+	"liche",
+	"ll",
+	"lle",
+	"llen",
+	"llen_",
+	"llt",
+	"llt\xc3",
+	"lltä",
+	"ln",
+	"lne",
+	"lnen",
+	"lnen_",
+	"lt",
+	"lte",
+	"lten",
+	"lten_",
+	"lt\xc3",
+	"ltä",
+	"ltäg",
+	"m",
+	"m_",
+	"m__",
+	"m___",
+	"m____",
+	"me",
+	"me_",
+	"me__",
+	"me___",
+	"mes",
+	"mess",
+	"messe",
+	"mi",
+	"mit",
+	"mit_",
+	"mit__",
+	"mm",
+	"mme",
+	"mme_",
+	"mme__",
+	"mp",
+	"mpa",
+	"mpak",
+	"mpakt",
+	"mu",
+	"mus",
+	"must",
+	"muste",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"nan",
+	"nann",
+	"nannt",
+	"nd",
+	"nd_",
+	"nd__",
+	"nd___",
+	"ne",
+	"ne_",
+	"ne__",
+	"ne___",
+	"nem",
+	"nem_",
+	"nem__",
+	"nen",
+	"nen_",
+	"nen__",
+	"ner",
+	"ner_",
+	"ner__",
+	"neu",
+	"neue",
+	"neuen",
+	"nf",
+	"nfo",
+	"nfol",
+	"nfolg",
+	"ng",
+	"ng_",
+	"ng__",
+	"ng___",
+	"nge",
+	"ngem",
+	"ngeme",
+	"nger",
+	"ngera",
+	"ngf",
+	"ngfo",
+	"ngfol",
+	"ngs",
+	"ngss",
+	"ngssy",
+	"ni",
+	"nie",
+	"nier",
+	"niert",
+	"nit",
+	"nitt",
+	"nitt_",
+	"nitte",
+	"nk",
+	"nkt",
+	"nkti",
+	"nktio",
+	"nm",
+	"nmu",
+	"nmus",
+	"nmust",
+	"nn",
+	"nn_",
+	"nn__",
+	"nn___",
+	"nnt",
+	"nnte",
+	"nnte_",
+	"nnten",
+	"nnu",
+	"nnun",
+	"nnung",
+	"nt",
+	"nte",
+	"nte_",
+	"nte__",
+	"nten",
+	"nten_",
+	"nter",
+	"nters",
+	"nu",
+	"nun",
+	"nung",
+	"nung_",
+	"nungs",
+	"nut",
+	"nutz",
+	"nutze",
+	"nz",
+	"nze",
+	"nzel",
+	"nzeln",
+	"o",
+	"od",
+	"ode",
+	"oder",
+	"oder_",
+	"of",
+	"ofi",
+	"ofil",
+	"ofile",
+	"og",
+	"oge",
+	"ogen",
+	"ogena",
+	"ol",
+	"olg",
+	"olge",
+	"olge_",
+	"olgen",
+	"om",
+	"omp",
+	"ompa",
+	"ompak",
+	"on",
+	"on_",
+	"on__",
+	"on___",
+	"oni",
+	"onie",
+	"onier",
+	"o\xc3",
+	"oß",
+	"oße",
+	"oßen",
+	"p",
+	"pa",
+	"pak",
+	"pakt",
+	"pakte",
+	"pi",
+	"pie",
+	"piel",
+	"piel_",
+	"pr",
+	"pra",
+	"prac",
+	"prach",
+	"p\xc3",
+	"pä",
+	"pät",
+	"päte",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"rab",
+	"rabd",
+	"rabdr",
+	"rac",
+	"rach",
+	"rache",
+	"ram",
+	"ramm",
+	"ramme",
+	"rc",
+	"rch",
+	"rch_",
+	"rch__",
+	"re",
+	"rei",
+	"reic",
+	"reich",
+	"ren",
+	"ren_",
+	"ren__",
+	"rg",
+	"rgl",
+	"rgle",
+	"rglei",
+	"rh",
+	"rhe",
+	"rhei",
+	"rheit",
+	"rk",
+	"rke",
+	"rkeh",
+	"rkehr",
+	"rken",
+	"rkenn",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"ro\xc3",
+	"roß",
+	"roße",
+	"rs",
+	"rst",
+	"rste",
+	"rstel",
+	"rsu",
+	"rsuc",
+	"rsuch",
+	"rt",
+	"rt_",
+	"rt__",
+	"rt___",
+	"ru",
+	"ruc",
+	"ruck",
+	"ruck_",
+	"rz",
+	"rze",
+	"rze_",
+	"rze__",
+	"rzen",
+	"rzen_",
+	"s",
+	"s_",
+	"s__",
+	"s___",
+	"s____",
+	"sc",
+	"sch",
+	"schn",
+	"schni",
+	"se",
+	"seh",
+	"sehr",
+	"sehr_",
+	"sei",
+	"sein",
+	"seine",
+	"seit",
+	"seite",
+	"sel",
+	"selb",
+	"selbs",
+	"selt",
+	"selte",
+	"sen",
+	"sene",
+	"sener",
+	"ser",
+	"ser_",
+	"ser__",
+	"so",
+	"sog",
+	"soge",
+	"sogen",
+	"sp",
+	"spi",
+	"spie",
+	"spiel",
+	"sp\xc3",
+	"spä",
+	"spät",
+	"sr",
+	"sre",
+	"srei",
+	"sreic",
+	"ss",
+	"ssc",
+	"ssch",
+	"sschn",
+	"sse",
+	"ssen",
+	"ssene",
+	"ssy",
+	"ssys",
+	"ssyst",
+	"st",
+	"st_",
+	"st__",
+	"st___",
+	"sta",
+	"stab",
+	"stabe",
+	"stabi",
+	"ste",
+	"ste_",
+	"ste__",
+	"stel",
+	"stell",
+	"stem",
+	"stem_",
+	"steme",
+	"ster",
+	"ster_",
+	"su",
+	"suc",
+	"such",
+	"sucht",
+	"sy",
+	"sys",
+	"syst",
+	"syste",
+	"t",
+	"t_",
+	"t__",
+	"t___",
+	"t____",
+	"ta",
+	"tab",
+	"tabe",
+	"taben",
+	"tabi",
+	"tabil",
+	"tabs",
+	"tabsc",
+	"tau",
+	"taus",
+	"tauss",
+	"tb",
+	"tbe",
+	"tbei",
+	"tbeis",
+	"te",
+	"te_",
+	"te__",
+	"te___",
+	"tel",
+	"tell",
+	"telle",
+	"tem",
+	"tem_",
+	"tem__",
+	"teme",
+	"teme_",
+	"ten",
+	"ten_",
+	"ten__",
+	"ter",
+	"ter_",
+	"ter__",
+	"ters",
+	"tersu",
+	"ti",
+	"tio",
+	"tion",
+	"tioni",
+	"tt",
+	"tt_",
+	"tt__",
+	"tt___",
+	"tte",
+	"tten",
+	"tten_",
+	"tz",
+	"tz_",
+	"tz__",
+	"tz___",
+	"tze",
+	"tzen",
+	"tzen_",
+	"t\xc3",
+	"tä",
+	"täg",
+	"tägl",
+	"u",
+	"u_",
+	"u__",
+	"u___",
+	"u____",
+	"uc",
+	"uch",
+	"uch_",
+	"uch__",
+	"uchl",
+	"uchle",
+	"uchs",
+	"uchst",
+	"ucht",
+	"ucht_",
+	"uck",
+	"uck_",
+	"uck__",
+	"ue",
+	"uen",
+	"uen_",
+	"uen__",
+	"uf",
+	"ufi",
+	"ufig",
+	"ufig_",
+	"ufigk",
+	"un",
+	"und",
+	"und_",
+	"und__",
+	"ung",
+	"ung_",
+	"ung__",
+	"ungs",
+	"ungss",
+	"unk",
+	"unkt",
+	"unkti",
+	"unt",
+	"unte",
+	"unter",
+	"ur",
+	"urc",
+	"urch",
+	"urch_",
+	"urz",
+	"urze",
+	"urze_",
+	"urzen",
+	"us",
+	"usr",
+	"usre",
+	"usrei",
+	"uss",
+	"ussc",
+	"ussch",
+	"ust",
+	"uste",
+	"uster",
+	"ut",
+	"ut_",
+	"ut__",
+	"ut___",
+	"ute",
+	"ute_",
+	"ute__",
+	"utz",
+	"utze",
+	"utzen",
+	"v",
+	"ve",
+	"ver",
+	"verg",
+	"vergl",
+	"vi",
+	"vie",
+	"viel",
+	"viele",
+	"vo",
+	"von",
+	"von_",
+	"von__",
+	"w",
+	"wi",
+	"wie",
+	"wied",
+	"wiede",
+	"x",
+	"xt",
+	"xt_",
+	"xt__",
+	"xt___",
+	"xta",
+	"xtab",
+	"xtabs",
+	"xtau",
+	"xtaus",
+	"xtb",
+	"xtbe",
+	"xtbei",
+	"y",
+	"ys",
+	"yst",
+	"yste",
+	"ystem",
+	"z",
+	"z_",
+	"z__",
+	"z___",
+	"z____",
+	"ze",
+	"ze_",
+	"ze__",
+	"ze___",
+	"zel",
+	"zeln",
+	"zelne",
+	"zen",
+	"zen_",
+	"zen__",
+	"zu",
+	"zu_",
+	"zu__",
+	"zu___",
+	"\x9f",
+	"\x9fe",
+	"\x9fen",
+	"\x9fen_",
+	"\x9fen__",
+	"\xa4",
+	"\xa4g",
+	"\xa4gl",
+	"\xa4gli",
+	"\xa4glic",
+	"\xa4t",
+	"\xa4te",
+	"\xa4ter",
+	"\xa4ter_",
+	"\xa4u",
+	"\xa4uf",
+	"\xa4ufi",
+	"\xa4ufig",
+	"\xc3",
+	"ß",
+	"ße",
+	"ßen",
+	"ßen_",
+	"ä",
+	"äg",
+	"ägl",
+	"ägli",
+	"ät",
+	"äte",
+	"äter",
+	"äu",
+	"äuf",
+	"äufi",
+}
+
+var germanRanks = []uint16{
+	323,
+	322,
+	321,
+	320,
+	1652,
+	566,
+	319,
+	1651,
+	1650,
+	1649,
+	1648,
+	1647,
+	1646,
+	1645,
+	1644,
+	1643,
+	1642,
+	1641,
+	1640,
+	1639,
+	1638,
+	1637,
+	1636,
+	1635,
+	1634,
+	1633,
+	1632,
+	1631,
+	1630,
+	318,
+	565,
+	564,
+	563,
+	562,
+	1629,
+	1628,
+	1627,
+	1626,
+	1625,
+	1624,
+	1623,
+	1622,
+	1621,
+	561,
+	560,
+	559,
+	558,
+	557,
+	1620,
+	1619,
+	1618,
+	1617,
+	1616,
+	1615,
+	1614,
+	1613,
+	1612,
+	1611,
+	70,
+	1610,
+	1609,
+	1608,
+	1607,
+	1606,
+	1605,
+	1604,
+	1603,
+	181,
+	180,
+	179,
+	178,
+	1602,
+	1601,
+	1600,
+	1599,
+	1598,
+	1597,
+	1596,
+	1595,
+	177,
+	176,
+	175,
+	174,
+	1594,
+	556,
+	1593,
+	1592,
+	1591,
+	1590,
+	1589,
+	1588,
+	317,
+	316,
+	315,
+	314,
+	313,
+	1587,
+	1586,
+	1585,
+	1584,
+	1583,
+	1582,
+	1581,
+	1580,
+	1579,
+	1578,
+	1577,
+	1576,
+	1575,
+	1574,
+	1573,
+	1572,
+	1571,
+	312,
+	555,
+	554,
+	553,
+	1570,
+	1569,
+	1568,
+	1567,
+	1566,
+	1565,
+	1564,
+	552,
+	551,
+	550,
+	549,
+	1563,
+	1562,
+	1561,
+	1560,
+	1559,
+	1558,
+	1557,
+	1556,
+	69,
+	1555,
+	1554,
+	1553,
+	1552,
+	1551,
+	1550,
+	173,
+	172,
+	171,
+	1549,
+	1548,
+	1547,
+	1546,
+	1545,
+	1544,
+	170,
+	169,
+	168,
+	167,
+	1543,
+	1542,
+	1541,
+	1540,
+	311,
+	310,
+	309,
+	308,
+	1539,
+	1538,
+	1537,
+	1536,
+	1535,
+	1534,
+	1533,
+	1532,
+	1531,
+	1530,
+	1529,
+	1528,
+	307,
+	548,
+	547,
+	1527,
+	1526,
+	1525,
+	1524,
+	1523,
+	546,
+	545,
+	544,
+	1522,
+	1521,
+	1520,
+	1519,
+	1518,
+	1517,
+	68,
+	1516,
+	1515,
+	1514,
+	1513,
+	166,
+	165,
+	1512,
+	1511,
+	1510,
+	1509,
+	164,
+	163,
+	162,
+	1508,
+	1507,
+	1506,
+	306,
+	305,
+	304,
+	1505,
+	1504,
+	1503,
+	1502,
+	1501,
+	1500,
+	1499,
+	303,
+	543,
+	1498,
+	1497,
+	1496,
+	542,
+	541,
+	1495,
+	1494,
+	1493,
+	1492,
+	67,
+	1491,
+	1490,
+	161,
+	1489,
+	1488,
+	160,
+	159,
+	1487,
+	1486,
+	302,
+	301,
+	1485,
+	1484,
+	300,
+	1483,
+	540,
+	1482,
+	1481,
+	66,
+	158,
+	1480,
+	299,
+	298,
+	297,
+	49,
+	1479,
+	296,
+	1478,
+	539,
+	1477,
+	106,
+	295,
+	538,
+	1476,
+	78,
+	294,
+	157,
+	1475,
+	1474,
+	293,
+	1473,
+	1472,
+	1471,
+	292,
+	291,
+	290,
+	289,
+	48,
+	55,
+	1470,
+	1469,
+	1468,
+	288,
+	1467,
+	1466,
+	1465,
+	1464,
+	1463,
+	537,
+	536,
+	1462,
+	1461,
+	105,
+	1460,
+	1459,
+	287,
+	286,
+	285,
+	535,
+	1458,
+	1457,
+	1456,
+	1455,
+	77,
+	156,
+	1454,
+	1453,
+	1452,
+	284,
+	283,
+	155,
+	534,
+	1451,
+	1450,
+	1449,
+	1448,
+	1447,
+	1446,
+	282,
+	1445,
+	1444,
+	1443,
+	1442,
+	1441,
+	1440,
+	281,
+	280,
+	533,
+	1439,
+	279,
+	278,
+	277,
+	47,
+	54,
+	53,
+	1438,
+	1437,
+	1436,
+	1435,
+	1434,
+	276,
+	1433,
+	1432,
+	1431,
+	1430,
+	1429,
+	1428,
+	1427,
+	1426,
+	1425,
+	532,
+	531,
+	530,
+	1424,
+	1423,
+	1422,
+	104,
+	1421,
+	1420,
+	1419,
+	1418,
+	275,
+	274,
+	273,
+	272,
+	271,
+	529,
+	1417,
+	1416,
+	1415,
+	1414,
+	1413,
+	1412,
+	1411,
+	76,
+	154,
+	1410,
+	1409,
+	528,
+	1408,
+	1407,
+	1406,
+	1405,
+	1404,
+	1403,
+	270,
+	269,
+	527,
+	1402,
+	153,
+	526,
+	525,
+	1401,
+	1400,
+	1399,
+	1398,
+	1397,
+	1396,
+	1395,
+	1394,
+	1393,
+	1392,
+	268,
+	1391,
+	1390,
+	1389,
+	1388,
+	1387,
+	1386,
+	1385,
+	1384,
+	1383,
+	267,
+	266,
+	524,
+	523,
+	1382,
+	1381,
+	265,
+	264,
+	263,
+	522,
+	1380,
+	46,
+	52,
+	51,
+	1379,
+	65,
+	1378,
+	1377,
+	1376,
+	1375,
+	1374,
+	1373,
+	1372,
+	1371,
+	262,
+	1370,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	1364,
+	1363,
+	1362,
+	1361,
+	1360,
+	1359,
+	1358,
+	521,
+	520,
+	519,
+	518,
+	1357,
+	1356,
+	1355,
+	1354,
+	103,
+	1353,
+	1352,
+	1351,
+	1350,
+	1349,
+	1348,
+	261,
+	260,
+	259,
+	258,
+	257,
+	256,
+	255,
+	517,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	1342,
+	1341,
+	1340,
+	1339,
+	1338,
+	75,
+	152,
+	1337,
+	1336,
+	1335,
+	1334,
+	516,
+	1333,
+	1332,
+	1331,
+	1330,
+	1329,
+	1328,
+	1327,
+	1326,
+	1325,
+	1324,
+	1323,
+	254,
+	253,
+	515,
+	514,
+	1322,
+	1321,
+	151,
+	513,
+	512,
+	511,
+	1320,
+	1319,
+	1318,
+	1317,
+	1316,
+	1315,
+	1314,
+	1313,
+	1312,
+	1311,
+	1310,
+	1309,
+	1308,
+	1307,
+	15,
+	102,
+	1306,
+	1305,
+	1304,
+	510,
+	509,
+	1303,
+	1302,
+	1301,
+	1300,
+	1299,
+	1298,
+	1297,
+	1296,
+	150,
+	149,
+	148,
+	508,
+	1295,
+	1294,
+	1293,
+	1292,
+	1291,
+	1290,
+	1289,
+	1288,
+	1287,
+	1286,
+	507,
+	506,
+	505,
+	504,
+	101,
+	503,
+	1285,
+	1284,
+	1283,
+	1282,
+	252,
+	1281,
+	1280,
+	502,
+	501,
+	1279,
+	1278,
+	1277,
+	1276,
+	1275,
+	1274,
+	1273,
+	1272,
+	500,
+	499,
+	1271,
+	1270,
+	1269,
+	1268,
+	45,
+	1267,
+	1266,
+	1265,
+	1264,
+	86,
+	251,
+	498,
+	497,
+	1263,
+	1262,
+	1261,
+	1260,
+	1259,
+	496,
+	1258,
+	1257,
+	1256,
+	1255,
+	1254,
+	1253,
+	1252,
+	1251,
+	250,
+	1250,
+	1249,
+	1248,
+	1247,
+	1246,
+	1245,
+	1244,
+	1243,
+	1242,
+	20,
+	22,
+	249,
+	248,
+	247,
+	64,
+	495,
+	494,
+	147,
+	246,
+	1241,
+	493,
+	1240,
+	1239,
+	1238,
+	1237,
+	1236,
+	492,
+	491,
+	490,
+	489,
+	488,
+	487,
+	486,
+	485,
+	484,
+	1235,
+	1234,
+	1233,
+	1232,
+	50,
+	245,
+	244,
+	243,
+	242,
+	241,
+	1231,
+	1230,
+	1229,
+	483,
+	1228,
+	1227,
+	1226,
+	1225,
+	240,
+	239,
+	482,
+	481,
+	1224,
+	1223,
+	1222,
+	1221,
+	1220,
+	1219,
+	1,
+	28,
+	27,
+	26,
+	25,
+	1218,
+	1217,
+	1216,
+	1215,
+	480,
+	479,
+	1214,
+	1213,
+	1212,
+	1211,
+	478,
+	477,
+	1210,
+	1209,
+	1208,
+	1207,
+	14,
+	476,
+	475,
+	474,
+	146,
+	145,
+	1206,
+	473,
+	1205,
+	44,
+	1204,
+	1203,
+	60,
+	1202,
+	472,
+	471,
+	144,
+	1201,
+	1200,
+	470,
+	1199,
+	1198,
+	1197,
+	1196,
+	238,
+	469,
+	468,
+	1195,
+	1194,
+	1193,
+	1192,
+	1191,
+	1190,
+	85,
+	1189,
+	1188,
+	1187,
+	1186,
+	1185,
+	1184,
+	1183,
+	1182,
+	1181,
+	1180,
+	1179,
+	1178,
+	1177,
+	1176,
+	1175,
+	1174,
+	1173,
+	1172,
+	84,
+	143,
+	142,
+	141,
+	467,
+	1171,
+	1170,
+	1169,
+	1168,
+	8,
+	19,
+	18,
+	17,
+	1167,
+	1166,
+	1165,
+	1164,
+	1163,
+	1162,
+	1161,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	1155,
+	1154,
+	1153,
+	466,
+	465,
+	464,
+	16,
+	43,
+	42,
+	41,
+	1152,
+	1151,
+	1150,
+	463,
+	462,
+	461,
+	1149,
+	1148,
+	1147,
+	460,
+	459,
+	1146,
+	1145,
+	458,
+	1144,
+	1143,
+	1142,
+	1141,
+	1140,
+	1139,
+	1138,
+	457,
+	1137,
+	1136,
+	1135,
+	1134,
+	1133,
+	1132,
+	1131,
+	1130,
+	1129,
+	1128,
+	140,
+	139,
+	1127,
+	1126,
+	456,
+	1125,
+	1124,
+	1123,
+	1122,
+	83,
+	237,
+	455,
+	1121,
+	1120,
+	1119,
+	1118,
+	1117,
+	1116,
+	1115,
+	454,
+	453,
+	452,
+	451,
+	1114,
+	1113,
+	1112,
+	1111,
+	24,
+	1110,
+	1109,
+	1108,
+	1107,
+	100,
+	1106,
+	1105,
+	1104,
+	1103,
+	1102,
+	1101,
+	450,
+	1100,
+	1099,
+	1098,
+	1097,
+	1096,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	1088,
+	1087,
+	1086,
+	138,
+	236,
+	235,
+	234,
+	1085,
+	1084,
+	1083,
+	1082,
+	1081,
+	1080,
+	1079,
+	1078,
+	1077,
+	1076,
+	1075,
+	1074,
+	1073,
+	1072,
+	1071,
+	13,
+	233,
+	232,
+	231,
+	230,
+	59,
+	449,
+	448,
+	447,
+	1070,
+	1069,
+	1068,
+	137,
+	229,
+	228,
+	1067,
+	1066,
+	446,
+	1065,
+	1064,
+	1063,
+	1062,
+	1061,
+	1060,
+	1059,
+	1058,
+	445,
+	444,
+	443,
+	442,
+	441,
+	1057,
+	1056,
+	1055,
+	1054,
+	1053,
+	1052,
+	440,
+	439,
+	438,
+	437,
+	436,
+	435,
+	434,
+	433,
+	1051,
+	1050,
+	1049,
+	1048,
+	3,
+	432,
+	431,
+	430,
+	429,
+	82,
+	81,
+	1047,
+	1046,
+	136,
+	227,
+	1045,
+	1044,
+	1043,
+	63,
+	226,
+	225,
+	224,
+	1042,
+	1041,
+	1040,
+	428,
+	1039,
+	1038,
+	1037,
+	1036,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	1030,
+	427,
+	1029,
+	1028,
+	1027,
+	1026,
+	1025,
+	1024,
+	426,
+	425,
+	1023,
+	1022,
+	1021,
+	1020,
+	30,
+	223,
+	222,
+	221,
+	58,
+	1019,
+	1018,
+	424,
+	423,
+	422,
+	421,
+	135,
+	134,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	1012,
+	1011,
+	1010,
+	1009,
+	1008,
+	420,
+	1007,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	62,
+	99,
+	98,
+	97,
+	1001,
+	1000,
+	999,
+	419,
+	998,
+	997,
+	996,
+	995,
+	994,
+	993,
+	992,
+	991,
+	990,
+	31,
+	989,
+	988,
+	987,
+	986,
+	418,
+	417,
+	416,
+	985,
+	984,
+	133,
+	983,
+	982,
+	981,
+	980,
+	979,
+	978,
+	415,
+	414,
+	413,
+	977,
+	976,
+	975,
+	974,
+	412,
+	973,
+	972,
+	971,
+	970,
+	969,
+	968,
+	220,
+	219,
+	218,
+	217,
+	21,
+	967,
+	966,
+	965,
+	964,
+	963,
+	962,
+	961,
+	960,
+	61,
+	132,
+	216,
+	215,
+	959,
+	958,
+	411,
+	410,
+	409,
+	408,
+	407,
+	406,
+	405,
+	404,
+	957,
+	956,
+	955,
+	954,
+	953,
+	952,
+	951,
+	950,
+	403,
+	949,
+	948,
+	947,
+	946,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	402,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	23,
+	131,
+	130,
+	129,
+	128,
+	127,
+	214,
+	213,
+	212,
+	933,
+	932,
+	931,
+	211,
+	210,
+	209,
+	208,
+	401,
+	400,
+	399,
+	398,
+	930,
+	929,
+	928,
+	927,
+	926,
+	925,
+	924,
+	923,
+	2,
+	12,
+	11,
+	10,
+	9,
+	922,
+	921,
+	920,
+	919,
+	207,
+	206,
+	205,
+	204,
+	32,
+	918,
+	917,
+	916,
+	397,
+	396,
+	395,
+	203,
+	202,
+	201,
+	96,
+	95,
+	94,
+	915,
+	914,
+	913,
+	912,
+	911,
+	910,
+	909,
+	93,
+	908,
+	907,
+	906,
+	394,
+	905,
+	904,
+	903,
+	902,
+	901,
+	900,
+	899,
+	898,
+	897,
+	896,
+	200,
+	895,
+	894,
+	893,
+	393,
+	392,
+	892,
+	891,
+	890,
+	889,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	92,
+	882,
+	881,
+	880,
+	391,
+	390,
+	879,
+	878,
+	389,
+	388,
+	387,
+	199,
+	198,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	197,
+	386,
+	385,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	57,
+	862,
+	861,
+	860,
+	859,
+	858,
+	857,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	384,
+	383,
+	382,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	381,
+	844,
+	843,
+	842,
+	841,
+	840,
+	839,
+	838,
+	837,
+	836,
+	835,
+	74,
+	834,
+	833,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	126,
+	125,
+	124,
+	123,
+	826,
+	825,
+	824,
+	823,
+	5,
+	40,
+	39,
+	38,
+	37,
+	73,
+	822,
+	821,
+	820,
+	122,
+	121,
+	120,
+	380,
+	379,
+	378,
+	819,
+	818,
+	817,
+	816,
+	377,
+	815,
+	814,
+	813,
+	812,
+	811,
+	810,
+	376,
+	375,
+	374,
+	373,
+	809,
+	808,
+	807,
+	806,
+	196,
+	195,
+	805,
+	804,
+	372,
+	371,
+	370,
+	803,
+	802,
+	801,
+	800,
+	799,
+	798,
+	369,
+	797,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	786,
+	785,
+	784,
+	194,
+	193,
+	783,
+	782,
+	368,
+	367,
+	6,
+	781,
+	780,
+	779,
+	778,
+	366,
+	365,
+	364,
+	363,
+	72,
+	777,
+	776,
+	775,
+	362,
+	774,
+	773,
+	772,
+	771,
+	361,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	757,
+	360,
+	756,
+	755,
+	754,
+	753,
+	752,
+	751,
+	750,
+	749,
+	748,
+	747,
+	192,
+	746,
+	745,
+	744,
+	743,
+	742,
+	741,
+	740,
+	739,
+	738,
+	56,
+	737,
+	736,
+	735,
+	191,
+	190,
+	359,
+	734,
+	91,
+	733,
+	732,
+	731,
+	730,
+	358,
+	729,
+	728,
+	727,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	4,
+	36,
+	35,
+	34,
+	33,
+	90,
+	119,
+	357,
+	356,
+	717,
+	716,
+	715,
+	714,
+	713,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	29,
+	118,
+	117,
+	116,
+	706,
+	705,
+	704,
+	355,
+	703,
+	702,
+	701,
+	700,
+	115,
+	114,
+	113,
+	189,
+	354,
+	353,
+	699,
+	698,
+	697,
+	696,
+	695,
+	694,
+	352,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	351,
+	687,
+	686,
+	685,
+	684,
+	683,
+	682,
+	681,
+	680,
+	679,
+	678,
+	7,
+	677,
+	676,
+	675,
+	674,
+	80,
+	89,
+	673,
+	672,
+	671,
+	670,
+	350,
+	349,
+	669,
+	668,
+	667,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	348,
+	347,
+	346,
+	660,
+	659,
+	79,
+	345,
+	344,
+	343,
+	342,
+	658,
+	657,
+	656,
+	655,
+	654,
+	653,
+	652,
+	651,
+	650,
+	649,
+	112,
+	648,
+	647,
+	646,
+	188,
+	187,
+	645,
+	341,
+	186,
+	644,
+	643,
+	642,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	185,
+	635,
+	634,
+	633,
+	632,
+	631,
+	630,
+	629,
+	628,
+	627,
+	111,
+	340,
+	339,
+	338,
+	337,
+	626,
+	625,
+	624,
+	623,
+	622,
+	621,
+	620,
+	619,
+	618,
+	617,
+	616,
+	615,
+	614,
+	110,
+	109,
+	613,
+	612,
+	611,
+	336,
+	610,
+	609,
+	608,
+	607,
+	606,
+	605,
+	604,
+	335,
+	334,
+	333,
+	332,
+	331,
+	71,
+	603,
+	602,
+	601,
+	600,
+	88,
+	599,
+	598,
+	597,
+	596,
+	595,
+	594,
+	184,
+	183,
+	182,
+	593,
+	592,
+	591,
+	590,
+	589,
+	588,
+	587,
+	586,
+	585,
+	108,
+	584,
+	583,
+	582,
+	581,
+	580,
+	579,
+	578,
+	577,
+	330,
+	329,
+	328,
+	327,
+	87,
+	576,
+	575,
+	574,
+	573,
+	107,
+	572,
+	571,
+	570,
+	569,
+	568,
+	567,
+	326,
+	325,
+	324,
+}
+
+var hebrewTokens = []string{
+	"____\xd7",
+	"___\xd7",
+	"___א",
+	"___ב",
+	"___ג",
+	"___ד",
+	"___ה",
+	"___ו",
+	"___ז",
+	"___ח",
+	"___ט",
+	"___י",
+	"___כ",
+	"___ל",
+	"___מ",
+	"___נ",
+	"___ס",
+	"___ע",
+	"___פ",
+	"___ק",
+	"___ר",
+	"___ש",
+	"___ת",
+	"__\xd7",
+	"__א",
+	"__א\xd7",
+	"__ב",
+	"__ב\xd7",
+	"__ג",
+	"__ג\xd7",
+	"__ד",
+	"__ד\xd7",
+	"__ה",
+	"__ה\xd7",
+	"__ו",
+	"__ו\xd7",
+	"__ז",
+	"__ז\xd7",
+	"__ח",
+	"__ח\xd7",
+	"__ט",
+	"__ט\xd7",
+	"__י",
+	"__י\xd7",
+	"__כ",
+	"__כ\xd7",
+	"__ל",
+	"__ל\xd7",
+	"__מ",
+	"__מ\xd7",
+	"__נ",
+	"__נ\xd7",
+	"__ס",
+	"__ס\xd7",
+	"__ע",
+	"__ע\xd7",
+	"__פ",
+	"__פ\xd7",
+	"__ק",
+	"__ק\xd7",
+	"__ר",
+	"__ר\xd7",
+	"__ש",
+	"__ש\xd7",
+	"__ת",
+	"__ת\xd7",
+	"_\xd7",
+	"_א",
+	"_א\xd7",
+	"_או",
+	"_אי",
+	"_אפ",
+	"_אצ",
+	"_את",
+	"_ב",
+	"_ב\xd7",
+	"_בב",
+	"_בו",
+	"_בי",
+	"_בכ",
+	"_בק",
+	"_בת",
+	"_ג",
+	"_ג\xd7",
+	"_גד",
+	"_גם",
+	"_ד",
+	"_ד\xd7",
+	"_דו",
+	"_די",
+	"_דף",
+	"_ה",
+	"_ה\xd7",
+	"_הא",
+	"_הז",
+	"_הל",
+	"_המ",
+	"_הנ",
+	"_ו",
+	"_ו\xd7",
+	"_וג",
+	"_ול",
+	"_ומ",
+	"_ז",
+	"_ז\xd7",
+	"_זי",
+	"_ח",
+	"_ח\xd7",
+	"_חד",
+	"_חי",
+	"_ט",
+	"_ט\xd7",
+	"_טב",
+	"_טו",
+	"_טק",
+	"_י",
+	"_י\xd7",
+	"_יד",
+	"_יו",
+	"_יכ",
+	"_יצ",
+	"_כ",
+	"_כ\xd7",
+	"_כת",
+	"_ל",
+	"_ל\xd7",
+	"_לב",
+	"_לה",
+	"_לח",
+	"_לט",
+	"_לנ",
+	"_לפ",
+	"_מ",
+	"_מ\xd7",
+	"_מא",
+	"_מה",
+	"_מו",
+	"_מכ",
+	"_מנ",
+	"_מס",
+	"_מע",
+	"_מש",
+	"_נ",
+	"_נ\xd7",
+	"_נג",
+	"_נת",
+	"_ס",
+	"_ס\xd7",
+	"_סב",
+	"_ספ",
+	"_ע",
+	"_ע\xd7",
+	"_עב",
+	"_על",
+	"_פ",
+	"_פ\xd7",
+	"_פו",
+	"_ק",
+	"_ק\xd7",
+	"_קו",
+	"_קט",
+	"_קצ",
+	"_ר",
+	"_ר\xd7",
+	"_רב",
+	"_רע",
+	"_רצ",
+	"_ש",
+	"_ש\xd7",
+	"_שה",
+	"_של",
+	"_שפ",
+	"_ת",
+	"_ת\xd7",
+	"_תב",
+	"\x90",
+	"\x90\xd7",
+	"\x90ו",
+	"\x90ו\xd7",
+	"\x90וד",
+	"\x90ות",
+	"\x90י",
+	"\x90י\xd7",
+	"\x90ינ",
+	"\x90פ",
+	"\x90פ\xd7",
+	"\x90פי",
+	"\x90צ",
+	"\x90צ\xd7",
+	"\x90צב",
+	"\x90ת",
+	"\x90ת_",
+	"\x90ת__",
+	"\x91",
+	"\x91\xd7",
+	"\x91ב",
+	"\x91ב\xd7",
+	"\x91בי",
+	"\x91ו",
+	"\x91ו\xd7",
+	"\x91וד",
+	"\x91ור",
+	"\x91ות",
+	"\x91י",
+	"\x91י\xd7",
+	"\x91יו",
+	"\x91יט",
+	"\x91יע",
+	"\x91יר",
+	"\x91כ",
+	"\x91כ\xd7",
+	"\x91כל",
+	"\x91נ",
+	"\x91נ\xd7",
+	"\x91נו",
+	"\x91ני",
+	"\x91ק",
+	"\x91ק\xd7",
+	"\x91קט",
+	"\x91ת",
+	"\x91ת\xd7",
+	"\x91תד",
+	"\x91תי",
+	"\x92",
+	"\x92\xd7",
+	"\x92ד",
+	"\x92ד\xd7",
+	"\x92דו",
+	"\x92ם",
+	"\x92ם_",
+	"\x92ם__",
+	"\x92ר",
+	"\x92ר\xd7",
+	"\x92רמ",
+	"\x93",
+	"\x93\xd7",
+	"\x93ו",
+	"\x93ו\xd7",
+	"\x93וג",
+	"\x93ול",
+	"\x93י",
+	"\x93י\xd7",
+	"\x93יר",
+	"\x93ף",
+	"\x93ף_",
+	"\x93ף__",
+	"\x94",
+	"\x94\xd7",
+	"\x94א",
+	"\x94א\xd7",
+	"\x94או",
+	"\x94אצ",
+	"\x94ו",
+	"\x94ו\xd7",
+	"\x94וק",
+	"\x94ז",
+	"\x94ז\xd7",
+	"\x94זו",
+	"\x94ל",
+	"\x94ל\xd7",
+	"\x94לל",
+	"\x94מ",
+	"\x94מ\xd7",
+	"\x94מע",
+	"\x94נ",
+	"\x94נ\xd7",
+	"\x94נג",
+	"\x94נו",
+	"\x94נפ",
+	"\x94נק",
+	"\x94ש",
+	"\x94ש\xd7",
+	"\x94שו",
+	"\x95",
+	"\x95\xd7",
+	"\x95ב",
+	"\x95ב\xd7",
+	"\x95בו",
+	"\x95ג",
+	"\x95ג\xd7",
+	"\x95גם",
+	"\x95גמ",
+	"\x95ד",
+	"\x95ד\xd7",
+	"\x95דד",
+	"\x95ט",
+	"\x95ט\xd7",
+	"\x95טי",
+	"\x95כ",
+	"\x95כ\xd7",
+	"\x95כר",
+	"\x95ל",
+	"\x95ל\xd7",
+	"\x95לא",
+	"\x95מ",
+	"\x95מ\xd7",
+	"\x95מי",
+	"\x95מפ",
+	"\x95מש",
+	"\x95ע",
+	"\x95ע\xd7",
+	"\x95על",
+	"\x95פ",
+	"\x95פ\xd7",
+	"\x95פי",
+	"\x95ק",
+	"\x95ק\xd7",
+	"\x95קל",
+	"\x95ת",
+	"\x95ת\xd7",
+	"\x95תו",
+	"\x95תי",
+	"\x96",
+	"\x96\xd7",
+	"\x96י",
+	"\x96י\xd7",
+	"\x96יה",
+	"\x97",
+	"\x97\xd7",
+	"\x97ד",
+	"\x97ד\xd7",
+	"\x97דש",
+	"\x97ז",
+	"\x97ז\xd7",
+	"\x97זו",
+	"\x97י",
+	"\x97י\xd7",
+	"\x97יפ",
+	"\x98",
+	"\x98\xd7",
+	"\x98ב",
+	"\x98ב\xd7",
+	"\x98בי",
+	"\x98ו",
+	"\x98ו\xd7",
+	"\x98וב",
+	"\x98ע",
+	"\x98ע\xd7",
+	"\x98עי",
+	"\x98ק",
+	"\x98ק\xd7",
+	"\x98קס",
+	"\x99",
+	"\x99\xd7",
+	"\x99ד",
+	"\x99ד\xd7",
+	"\x99די",
+	"\x99ה",
+	"\x99ה\xd7",
+	"\x99הו",
+	"\x99ו",
+	"\x99ו\xd7",
+	"\x99ומ",
+	"\x99ות",
+	"\x99ט",
+	"\x99ט\xd7",
+	"\x99טח",
+	"\x99כ",
+	"\x99כ\xd7",
+	"\x99כו",
+	"\x99נ",
+	"\x99נ\xd7",
+	"\x99נט",
+	"\x99פ",
+	"\x99פ\xd7",
+	"\x99פו",
+	"\x99צ",
+	"\x99צ\xd7",
+	"\x99צי",
+	"\x99ר",
+	"\x99ר\xd7",
+	"\x99רו",
+	"\x9b",
+	"\x9b\xd7",
+	"\x9bו",
+	"\x9bו\xd7",
+	"\x9bול",
+	"\x9bר",
+	"\x9bר\xd7",
+	"\x9bרי",
+	"\x9bת",
+	"\x9bת\xd7",
+	"\x9bתי",
+	"\x9c",
+	"\x9c\xd7",
+	"\x9cא",
+	"\x9cא\xd7",
+	"\x9cאח",
+	"\x9cב",
+	"\x9cב\xd7",
+	"\x9cבנ",
+	"\x9cה",
+	"\x9cה\xd7",
+	"\x9cהש",
+	"\x9cח",
+	"\x9cח\xd7",
+	"\x9cחז",
+	"\x9cט",
+	"\x9cט\xd7",
+	"\x9cטב",
+	"\x9cל",
+	"\x9cל\xd7",
+	"\x9cלו",
+	"\x9cנ",
+	"\x9cנ\xd7",
+	"\x9cנד",
+	"\x9cפ",
+	"\x9cפ\xd7",
+	"\x9cפר",
+	"\x9e",
+	"\x9e\xd7",
+	"\x9eא",
+	"\x9eא\xd7",
+	"\x9eאו",
+	"\x9eה",
+	"\x9eה\xd7",
+	"\x9eהנ",
+	"\x9eו",
+	"\x9eו\xd7",
+	"\x9eוכ",
+	"\x9eי",
+	"\x9eי\xd7",
+	"\x9eיו",
+	"\x9eכ",
+	"\x9eכ\xd7",
+	"\x9eכן",
+	"\x9eנ",
+	"\x9eנ\xd7",
+	"\x9eנת",
+	"\x9eס",
+	"\x9eס\xd7",
+	"\x9eספ",
+	"\x9eסת",
+	"\x9eע",
+	"\x9eע\xd7",
+	"\x9eער",
+	"\x9eפ",
+	"\x9eפ\xd7",
+	"\x9eפק",
+	"\x9eש",
+	"\x9eש\xd7",
+	"\x9eשו",
+	"\x9eשפ",
+	"\xa0",
+	"\xa0\xd7",
+	"\xa0ג",
+	"\xa0ג\xd7",
+	"\xa0גר",
+	"\xa0ד",
+	"\xa0ד\xd7",
+	"\xa0די",
+	"\xa0ו",
+	"\xa0ו\xd7",
+	"\xa0וט",
+	"\xa0ט",
+	"\xa0ט\xd7",
+	"\xa0טר",
+	"\xa0י",
+	"\xa0י\xd7",
+	"\xa0יו",
+	"\xa0פ",
+	"\xa0פ\xd7",
+	"\xa0פו",
+	"\xa0ק",
+	"\xa0ק\xd7",
+	"\xa0קר",
+	"\xa0ת",
+	"\xa0ת\xd7",
+	"\xa0תו",
+	"\xa0תח",
+	"\xa1",
+	"\xa1\xd7",
+	"\xa1ב",
+	"\xa1ב\xd7",
+	"\xa1בי",
+	"\xa1פ",
+	"\xa1פ\xd7",
+	"\xa1פי",
+	"\xa1פר",
+	"\xa1ת",
+	"\xa1ת\xd7",
+	"\xa1תמ",
+	"\xa2",
+	"\xa2\xd7",
+	"\xa2ב",
+	"\xa2ב\xd7",
+	"\xa2בו",
+	"\xa2י",
+	"\xa2י\xd7",
+	"\xa2יו",
+	"\xa2ל",
+	"\xa2ל_",
+	"\xa2ל__",
+	"\xa2ר",
+	"\xa2ר\xd7",
+	"\xa2רכ",
+	"\xa4",
+	"\xa4\xd7",
+	"\xa4ו",
+	"\xa4ו\xd7",
+	"\xa4וע",
+	"\xa4ות",
+	"\xa4י",
+	"\xa4י\xd7",
+	"\xa4יל",
+	"\xa4ק",
+	"\xa4ק\xd7",
+	"\xa4קט",
+	"\xa4ר",
+	"\xa4ר\xd7",
+	"\xa4רו",
+	"\xa6",
+	"\xa6\xd7",
+	"\xa6ב",
+	"\xa6ב\xd7",
+	"\xa6בע",
+	"\xa6י",
+	"\xa6י\xd7",
+	"\xa6יב",
+	"\xa6פ",
+	"\xa6פ\xd7",
+	"\xa6פי",
+	"\xa6ר",
+	"\xa6ר\xd7",
+	"\xa6רי",
+	"\xa7",
+	"\xa7\xd7",
+	"\xa7ו",
+	"\xa7ו\xd7",
+	"\xa7ומ",
+	"\xa7ט",
+	"\xa7ט\xd7",
+	"\xa7טע",
+	"\xa7ס",
+	"\xa7ס\xd7",
+	"\xa7סט",
+	"\xa7צ",
+	"\xa7צ\xd7",
+	"\xa7צר",
+	"\xa7ר",
+	"\xa7ר\xd7",
+	"\xa7רא",
+	"\xa8",
+	"\xa8\xd7",
+	"\xa8ב",
+	"\xa8ב\xd7",
+	"\xa8בו",
+	"\xa8ו",
+	"\xa8ו\xd7",
+	"\xa8ופ",
+	"\xa8כ",
+	"\xa8כ\xd7",
+	"\xa8כו",
+	"\xa8מ",
+	"\xa8מ\xd7",
+	"\xa8מי",
+	"\xa8ע",
+	"\xa8ע\xd7",
+	"\xa8עי",
+	"\xa8צ",
+	"\xa8צ\xd7",
+	"\xa8צפ",
+	"\xa9",
+	"\xa9\xd7",
+	"\xa9ה",
+	"\xa9ה\xd7",
+	"\xa9הו",
+	"\xa9ו",
+	"\xa9ו\xd7",
+	"\xa9וו",
+	"\xa9ל",
+	"\xa9ל_",
+	"\xa9ל__",
+	"\xa9ל\xd7",
+	"\xa9לו",
+	"\xa9פ",
+	"\xa9פ\xd7",
+	"\xa9פה",
+	"\xa9פו",
+	"\xa9פט",
+	"\xaa",
+	"\xaa\xd7",
+	"\xaaב",
+	"\xaaב\xd7",
+	"\xaaבנ",
+	"\xaaד",
+	"\xaaד\xd7",
+	"\xaaדי",
+	"\xaaו",
+	"\xaaו\xd7",
+	"\xaaונ",
+	"\xaaי",
+	"\xaaי\xd7",
+	"\xaaיב",
+	"\xaaיו",
+	"\xaaמ",
+	"\xaaמ\xd7",
+	"\xaaמכ",
+	"\xd7",
+	"א",
+	"א\xd7",
+	"או",
+	"או\xd7",
+	"אח",
+	"אח\xd7",
+	"אי",
+	"אי\xd7",
+	"אפ",
+	"אפ\xd7",
+	"אצ",
+	"אצ\xd7",
+	"את",
+	"את_",
+	"ב",
+	"ב\xd7",
+	"בב",
+	"בב\xd7",
+	"בו",
+	"בו\xd7",
+	"בי",
+	"בי\xd7",
+	"בכ",
+	"בכ\xd7",
+	"בנ",
+	"בנ\xd7",
+	"בק",
+	"בק\xd7",
+	"בת",
+	"בת\xd7",
+	"ג",
+	"ג\xd7",
+	"גד",
+	"גד\xd7",
+	"גם",
+	"גם_",
+	"גמ",
+	"גמ\xd7",
+	"גר",
+	"גר\xd7",
+	"ד",
+	"ד\xd7",
+	"דו",
+	"דו\xd7",
+	"די",
+	"די_",
+	"די\xd7",
+	"דף",
+	"דף_",
+	"דש",
+	"דש_",
+	"ה",
+	"ה\xd7",
+	"הא",
+	"הא\xd7",
+	"הו",
+	"הו\xd7",
+	"הז",
+	"הז\xd7",
+	"הל",
+	"הל\xd7",
+	"המ",
+	"המ\xd7",
+	"הנ",
+	"הנ\xd7",
+	"הש",
+	"הש\xd7",
+	"ו",
+	"ו\xd7",
+	"וב",
+	"וב\xd7",
+	"וג",
+	"וג\xd7",
+	"וד",
+	"וד\xd7",
+	"וט",
+	"וט\xd7",
+	"וכ",
+	"וכ\xd7",
+	"ול",
+	"ול\xd7",
+	"ומ",
+	"ומ\xd7",
+	"ונ",
+	"ונ\xd7",
+	"וע",
+	"וע\xd7",
+	"ופ",
+	"ופ\xd7",
+	"וק",
+	"וק\xd7",
+	"ות",
+	"ות\xd7",
+	"ז",
+	"ז\xd7",
+	"זו",
+	"זו_",
+	"זו\xd7",
+	"זי",
+	"זי\xd7",
+	"ח",
+	"ח\xd7",
+	"חד",
+	"חד\xd7",
+	"חז",
+	"חז\xd7",
+	"חי",
+	"חי\xd7",
+	"ט",
+	"ט\xd7",
+	"טב",
+	"טב\xd7",
+	"טו",
+	"טו\xd7",
+	"טח",
+	"טח\xd7",
+	"טע",
+	"טע_",
+	"טע\xd7",
+	"טק",
+	"טק\xd7",
+	"טר",
+	"טר\xd7",
+	"י",
+	"י\xd7",
+	"יב",
+	"יב\xd7",
+	"יד",
+	"יד\xd7",
+	"יה",
+	"יה\xd7",
+	"יו",
+	"יו\xd7",
+	"יט",
+	"יט\xd7",
+	"יכ",
+	"יכ\xd7",
+	"יל",
+	"יל\xd7",
+	"ינ",
+	"ינ\xd7",
+	"יע",
+	"יע\xd7",
+	"יפ",
+	"יפ\xd7",
+	"יצ",
+	"יצ\xd7",
+	"יר",
+	"יר\xd7",
+	"כ",
+	"כ\xd7",
+	"כו",
+	"כו\xd7",
+	"כל",
+	"כל_",
+	"כן",
+	"כן_",
+	"כר",
+	"כר\xd7",
+	"כת",
+	"כת\xd7",
+	"ל",
+	"ל\xd7",
+	"לא",
+	"לא\xd7",
+	"לב",
+	"לב\xd7",
+	"לה",
+	"לה\xd7",
+	"לו",
+	"לו_",
+	"לח",
+	"לח\xd7",
+	"לט",
+	"לט\xd7",
+	"לל",
+	"לל\xd7",
+	"לנ",
+	"לנ\xd7",
+	"לפ",
+	"לפ\xd7",
+	"מ",
+	"מ\xd7",
+	"מא",
+	"מא\xd7",
+	"מה",
+	"מה\xd7",
+	"מו",
+	"מו\xd7",
+	"מי",
+	"מי\xd7",
+	"מכ",
+	"מכ\xd7",
+	"מנ",
+	"מנ\xd7",
+	"מס",
+	"מס\xd7",
+	"מע",
+	"מע\xd7",
+	"מפ",
+	"מפ\xd7",
+	"מש",
+	"מש\xd7",
+	"נ",
+	"נ\xd7",
+	"נג",
+	"נג\xd7",
+	"נד",
+	"נד\xd7",
+	"נו",
+	"נו\xd7",
+	"נט",
+	"נט\xd7",
+	"ני",
+	"ני\xd7",
+	"נפ",
+	"נפ\xd7",
+	"נק",
+	"נק\xd7",
+	"נת",
+	"נת\xd7",
+	"ס",
+	"ס\xd7",
+	"סב",
+	"סב\xd7",
+	"ספ",
+	"ספ\xd7",
+	"סת",
+	"סת\xd7",
+	"ע",
+	"ע\xd7",
+	"עב",
+	"עב\xd7",
+	"עי",
+	"עי\xd7",
+	"על",
+	"על_",
+	"ער",
+	"ער\xd7",
+	"פ",
+	"פ\xd7",
+	"פה",
+	"פה_",
+	"פו",
+	"פו\xd7",
+	"פי",
+	"פי\xd7",
+	"פק",
+	"פק\xd7",
+	"פר",
+	"פר_",
+	"פר\xd7",
+	"צ",
+	"צ\xd7",
+	"צב",
+	"צב\xd7",
+	"צי",
+	"צי\xd7",
+	"צפ",
+	"צפ\xd7",
+	"צר",
+	"צר_",
+	"צר\xd7",
+	"ק",
+	"ק\xd7",
+	"קו",
+	"קו\xd7",
+	"קט",
+	"קט\xd7",
+	"קס",
+	"קס\xd7",
+	"קצ",
+	"קצ\xd7",
+	"קר",
+	"קר\xd7",
+	"ר",
+	"ר\xd7",
+	"רא",
+	"רא\xd7",
+	"רב",
+	"רב\xd7",
+	"רו",
+	"רו\xd7",
+	"רי",
+	"רי\xd7",
+	"רכ",
+	"רכ\xd7",
+	"רמ",
+	"רמ\xd7",
+	"רע",
+	"רע\xd7",
+	"רצ",
+	"רצ\xd7",
+	"ש",
+	"ש\xd7",
+	"שה",
+	"שה\xd7",
+	"שו",
+	"שו\xd7",
+	"של",
+	"של_",
+	"של\xd7",
+	"שפ",
+	"שפ\xd7",
+	"ת",
+	"ת\xd7",
+	"תב",
+	"תב\xd7",
+	"תד",
+	"תד\xd7",
+	"תו",
+	"תו\xd7",
+	"תי",
+	"תי\xd7",
+	"תמ",
+	"תמ\xd7",
+}
+
+var hebrewRanks = []uint16{
+	5,
+	4,
+	108,
+	79,
+	237,
+	236,
+	78,
+	235,
+	404,
+	403,
+	173,
+	172,
+	915,
+	107,
+	59,
+	402,
+	401,
+	141,
+	914,
+	140,
+	234,
+	40,
+	913,
+	3,
+	106,
+	105,
+	77,
+	76,
+	233,
+	232,
+	231,
+	230,
+	75,
+	74,
+	229,
+	228,
+	400,
+	399,
+	398,
+	397,
+	171,
+	170,
+	169,
+	168,
+	912,
+	911,
+	104,
+	103,
+	58,
+	57,
+	396,
+	395,
+	394,
+	393,
+	139,
+	138,
+	910,
+	909,
+	137,
+	136,
+	227,
+	226,
+	39,
+	38,
+	908,
+	907,
+	2,
+	102,
+	101,
+	392,
+	906,
+	905,
+	904,
+	903,
+	73,
+	72,
+	902,
+	901,
+	391,
+	900,
+	899,
+	390,
+	225,
+	224,
+	898,
+	389,
+	223,
+	222,
+	897,
+	896,
+	895,
+	71,
+	70,
+	388,
+	894,
+	893,
+	892,
+	221,
+	220,
+	219,
+	891,
+	890,
+	889,
+	387,
+	386,
+	385,
+	384,
+	383,
+	888,
+	887,
+	167,
+	166,
+	886,
+	885,
+	382,
+	165,
+	164,
+	884,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	100,
+	99,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	56,
+	55,
+	871,
+	870,
+	869,
+	868,
+	867,
+	381,
+	866,
+	865,
+	380,
+	379,
+	864,
+	863,
+	378,
+	377,
+	862,
+	861,
+	135,
+	134,
+	218,
+	376,
+	860,
+	859,
+	858,
+	133,
+	132,
+	857,
+	856,
+	217,
+	216,
+	215,
+	855,
+	854,
+	853,
+	37,
+	36,
+	852,
+	98,
+	163,
+	851,
+	850,
+	849,
+	54,
+	53,
+	162,
+	161,
+	848,
+	214,
+	847,
+	846,
+	845,
+	844,
+	843,
+	842,
+	375,
+	374,
+	373,
+	841,
+	840,
+	839,
+	13,
+	12,
+	838,
+	837,
+	836,
+	131,
+	130,
+	835,
+	213,
+	834,
+	97,
+	96,
+	372,
+	833,
+	371,
+	832,
+	831,
+	830,
+	829,
+	370,
+	369,
+	828,
+	827,
+	826,
+	825,
+	824,
+	368,
+	367,
+	823,
+	822,
+	129,
+	128,
+	821,
+	820,
+	819,
+	366,
+	365,
+	364,
+	363,
+	362,
+	361,
+	127,
+	126,
+	360,
+	359,
+	818,
+	817,
+	358,
+	357,
+	356,
+	816,
+	815,
+	814,
+	35,
+	34,
+	355,
+	354,
+	813,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	803,
+	802,
+	801,
+	800,
+	160,
+	159,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	15,
+	14,
+	792,
+	791,
+	790,
+	353,
+	352,
+	789,
+	788,
+	787,
+	786,
+	785,
+	784,
+	783,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	776,
+	212,
+	211,
+	775,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	210,
+	209,
+	763,
+	351,
+	350,
+	349,
+	348,
+	347,
+	346,
+	208,
+	207,
+	762,
+	761,
+	760,
+	759,
+	758,
+	757,
+	756,
+	755,
+	754,
+	95,
+	94,
+	345,
+	344,
+	343,
+	753,
+	752,
+	751,
+	750,
+	749,
+	748,
+	342,
+	341,
+	340,
+	29,
+	28,
+	747,
+	746,
+	745,
+	339,
+	338,
+	337,
+	158,
+	157,
+	336,
+	335,
+	744,
+	743,
+	742,
+	741,
+	740,
+	739,
+	738,
+	737,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	728,
+	727,
+	206,
+	205,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	69,
+	68,
+	717,
+	716,
+	715,
+	714,
+	713,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	706,
+	705,
+	704,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	696,
+	695,
+	694,
+	27,
+	26,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	686,
+	685,
+	684,
+	683,
+	682,
+	681,
+	680,
+	679,
+	678,
+	677,
+	676,
+	334,
+	333,
+	675,
+	674,
+	332,
+	331,
+	330,
+	673,
+	672,
+	671,
+	329,
+	328,
+	670,
+	669,
+	44,
+	43,
+	327,
+	326,
+	325,
+	668,
+	667,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	654,
+	653,
+	652,
+	651,
+	324,
+	323,
+	650,
+	649,
+	156,
+	155,
+	648,
+	647,
+	646,
+	322,
+	321,
+	645,
+	644,
+	643,
+	642,
+	641,
+	67,
+	66,
+	204,
+	203,
+	202,
+	640,
+	639,
+	638,
+	320,
+	319,
+	318,
+	317,
+	316,
+	315,
+	125,
+	124,
+	314,
+	313,
+	637,
+	636,
+	635,
+	634,
+	633,
+	632,
+	631,
+	630,
+	629,
+	628,
+	627,
+	123,
+	122,
+	626,
+	625,
+	624,
+	623,
+	622,
+	621,
+	620,
+	619,
+	618,
+	312,
+	311,
+	310,
+	52,
+	51,
+	617,
+	616,
+	615,
+	309,
+	308,
+	307,
+	306,
+	305,
+	304,
+	201,
+	200,
+	199,
+	614,
+	613,
+	612,
+	93,
+	92,
+	611,
+	610,
+	609,
+	608,
+	607,
+	606,
+	605,
+	604,
+	603,
+	602,
+	601,
+	600,
+	599,
+	598,
+	597,
+	596,
+	595,
+	594,
+	21,
+	20,
+	593,
+	592,
+	591,
+	303,
+	302,
+	301,
+	91,
+	121,
+	120,
+	590,
+	589,
+	119,
+	118,
+	198,
+	588,
+	587,
+	85,
+	84,
+	586,
+	585,
+	584,
+	583,
+	582,
+	581,
+	580,
+	579,
+	578,
+	197,
+	196,
+	300,
+	577,
+	576,
+	575,
+	574,
+	1,
+	42,
+	41,
+	154,
+	153,
+	573,
+	572,
+	571,
+	570,
+	569,
+	568,
+	299,
+	298,
+	567,
+	566,
+	11,
+	10,
+	565,
+	564,
+	90,
+	89,
+	88,
+	87,
+	563,
+	562,
+	297,
+	296,
+	561,
+	560,
+	295,
+	294,
+	83,
+	82,
+	559,
+	558,
+	195,
+	194,
+	557,
+	556,
+	293,
+	292,
+	65,
+	64,
+	291,
+	290,
+	152,
+	555,
+	193,
+	554,
+	553,
+	552,
+	551,
+	25,
+	24,
+	289,
+	288,
+	192,
+	191,
+	550,
+	549,
+	548,
+	547,
+	546,
+	545,
+	151,
+	150,
+	544,
+	543,
+	7,
+	6,
+	542,
+	541,
+	287,
+	286,
+	540,
+	539,
+	538,
+	537,
+	536,
+	535,
+	190,
+	189,
+	188,
+	187,
+	534,
+	533,
+	532,
+	531,
+	530,
+	529,
+	528,
+	527,
+	117,
+	116,
+	149,
+	148,
+	285,
+	526,
+	525,
+	284,
+	283,
+	186,
+	185,
+	524,
+	523,
+	522,
+	521,
+	520,
+	519,
+	50,
+	49,
+	282,
+	281,
+	518,
+	517,
+	516,
+	515,
+	280,
+	514,
+	513,
+	279,
+	278,
+	512,
+	511,
+	9,
+	8,
+	184,
+	183,
+	510,
+	509,
+	277,
+	276,
+	115,
+	114,
+	508,
+	507,
+	506,
+	505,
+	504,
+	503,
+	502,
+	501,
+	500,
+	499,
+	498,
+	497,
+	496,
+	495,
+	275,
+	274,
+	113,
+	112,
+	494,
+	493,
+	492,
+	491,
+	490,
+	489,
+	488,
+	487,
+	486,
+	485,
+	48,
+	47,
+	484,
+	483,
+	482,
+	481,
+	480,
+	479,
+	478,
+	477,
+	476,
+	475,
+	474,
+	473,
+	472,
+	471,
+	470,
+	469,
+	468,
+	467,
+	19,
+	18,
+	466,
+	465,
+	464,
+	463,
+	462,
+	461,
+	460,
+	459,
+	273,
+	272,
+	458,
+	457,
+	271,
+	270,
+	269,
+	268,
+	456,
+	455,
+	267,
+	266,
+	33,
+	32,
+	265,
+	264,
+	454,
+	453,
+	263,
+	262,
+	452,
+	451,
+	450,
+	449,
+	448,
+	447,
+	446,
+	445,
+	261,
+	260,
+	147,
+	146,
+	444,
+	443,
+	259,
+	258,
+	442,
+	441,
+	63,
+	62,
+	182,
+	181,
+	440,
+	439,
+	257,
+	256,
+	255,
+	254,
+	23,
+	22,
+	180,
+	179,
+	178,
+	177,
+	145,
+	144,
+	438,
+	437,
+	253,
+	436,
+	435,
+	81,
+	80,
+	252,
+	251,
+	434,
+	433,
+	432,
+	431,
+	176,
+	430,
+	250,
+	46,
+	45,
+	429,
+	428,
+	249,
+	248,
+	247,
+	246,
+	175,
+	174,
+	427,
+	426,
+	31,
+	30,
+	425,
+	424,
+	423,
+	422,
+	245,
+	244,
+	243,
+	242,
+	421,
+	420,
+	241,
+	240,
+	419,
+	418,
+	417,
+	416,
+	17,
+	16,
+	415,
+	414,
+	239,
+	238,
+	86,
+	111,
+	413,
+	110,
+	109,
+	61,
+	60,
+	412,
+	411,
+	410,
+	409,
+	408,
+	407,
+	143,
+	142,
+	406,
+	405,
+}
+
+var russianTokens = []string{
+	"____n",
+	"____\xd0",
+	"____\xd1",
+	"___n",
+	"___n_",
+	"___\xd0",
+	"___О",
+	"___Р",
+	"___Т",
+	"___Х",
+	"___а",
+	"___б",
+	"___в",
+	"___г",
+	"___д",
+	"___е",
+	"___ж",
+	"___з",
+	"___и",
+	"___к",
+	"___м",
+	"___н",
+	"___о",
+	"___п",
+	"___\xd1",
+	"___р",
+	"___с",
+	"___т",
+	"___у",
+	"___ф",
+	"___ч",
+	"___э",
+	"___я",
+	"__n",
+	"__n_",
+	"__n__",
+	"__\xd0",
+	"__О",
+	"__О\xd0",
+	"__Р",
+	"__Р\xd0",
+	"__Т",
+	"__Т\xd0",
+	"__Х",
+	"__Х\xd0",
+	"__а",
+	"__а\xd0",
+	"__б",
+	"__б\xd0",
+	"__б\xd1",
+	"__в",
+	"__в_",
+	"__в\xd0",
+	"__г",
+	"__г\xd1",
+	"__д",
+	"__д\xd0",
+	"__е",
+	"__е\xd0",
+	"__ж",
+	"__ж\xd0",
+	"__з",
+	"__з\xd0",
+	"__и",
+	"__и_",
+	"__и\xd0",
+	"__к",
+	"__к_",
+	"__к\xd0",
+	"__м",
+	"__м\xd0",
+	"__н",
+	"__н\xd0",
+	"__о",
+	"__о\xd0",
+	"__о\xd1",
+	"__п",
+	"__п\xd0",
+	"__п\xd1",
+	"__\xd1",
+	"__р",
+	"__р\xd0",
+	"__с",
+	"__с_",
+	"__с\xd0",
+	"__с\xd1",
+	"__т",
+	"__т\xd0",
+	"__у",
+	"__у\xd0",
+	"__ф",
+	"__ф\xd1",
+	"__ч",
+	"__ч\xd0",
+	"__э",
+	"__э\xd1",
+	"__я",
+	"__я\xd0",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_\xd0",
+	"_О",
+	"_О\xd0",
+	"_Оп",
+	"_Р",
+	"_Р\xd0",
+	"_Ра",
+	"_Т",
+	"_Т\xd0",
+	"_Та",
+	"_Х",
+	"_Х\xd0",
+	"_Хо",
+	"_а",
+	"_а\xd0",
+	"_ан",
+	"_б",
+	"_б\xd0",
+	"_бо",
+	"_б\xd1",
+	"_бу",
+	"_в",
+	"_в_",
+	"_в__",
+	"_в\xd0",
+	"_вв",
+	"_ве",
+	"_г",
+	"_г\xd1",
+	"_гр",
+	"_д",
+	"_д\xd0",
+	"_да",
+	"_дл",
+	"_до",
+	"_е",
+	"_е\xd0",
+	"_ег",
+	"_ж",
+	"_ж\xd0",
+	"_же",
+	"_з",
+	"_з\xd0",
+	"_за",
+	"_и",
+	"_и_",
+	"_и__",
+	"_и\xd0",
+	"_ид",
+	"_из",
+	"_к",
+	"_к_",
+	"_к__",
+	"_к\xd0",
+	"_кн",
+	"_ко",
+	"_м",
+	"_м\xd0",
+	"_мн",
+	"_мо",
+	"_н",
+	"_н\xd0",
+	"_на",
+	"_но",
+	"_о",
+	"_о\xd0",
+	"_об",
+	"_од",
+	"_оп",
+	"_о\xd1",
+	"_от",
+	"_оч",
+	"_п",
+	"_п\xd0",
+	"_по",
+	"_п\xd1",
+	"_пр",
+	"_\xd1",
+	"_р",
+	"_р\xd0",
+	"_ра",
+	"_ре",
+	"_с",
+	"_с_",
+	"_с__",
+	"_с\xd0",
+	"_са",
+	"_си",
+	"_со",
+	"_с\xd1",
+	"_ср",
+	"_ст",
+	"_т",
+	"_т\xd0",
+	"_те",
+	"_у",
+	"_у\xd0",
+	"_ув",
+	"_ф",
+	"_ф\xd1",
+	"_фр",
+	"_ч",
+	"_ч\xd0",
+	"_ча",
+	"_э",
+	"_э\xd1",
+	"_эт",
+	"_я",
+	"_я\xd0",
+	"_яз",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"\x80",
+	"\x80\xd0",
+	"\x80а",
+	"\x80а\xd0",
+	"\x80аб",
+	"\x80ав",
+	"\x80аз",
+	"\x80ам",
+	"\x80ан",
+	"\x80а\xd1",
+	"\x80аю",
+	"\x80е",
+	"\x80е\xd0",
+	"\x80ед",
+	"\x80ен",
+	"\x80о",
+	"\x80о\xd0",
+	"\x80ок",
+	"\x80о\xd1",
+	"\x80от",
+	"\x80оф",
+	"\x80ош",
+	"\x80\xd1",
+	"\x80ы",
+	"\x80ы\xd0",
+	"\x80ыв",
+	"\x81",
+	"\x81\xd0",
+	"\x81а",
+	"\x81а\xd0",
+	"\x81ам",
+	"\x81е",
+	"\x81е\xd0",
+	"\x81ед",
+	"\x81и",
+	"\x81и\xd1",
+	"\x81ис",
+	"\x81л",
+	"\x81л\xd0",
+	"\x81ле",
+	"\x81о",
+	"\x81о_",
+	"\x81о__",
+	"\x81\xd1",
+	"\x81р",
+	"\x81р\xd0",
+	"\x81ра",
+	"\x81т",
+	"\x81т\xd0",
+	"\x81та",
+	"\x81те",
+	"\x81тн",
+	"\x81то",
+	"\x81т\xd1",
+	"\x81тр",
+	"\x81ты",
+	"\x82",
+	"\x82\xd0",
+	"\x82а",
+	"\x82а\xd0",
+	"\x82аб",
+	"\x82а\xd1",
+	"\x82ат",
+	"\x82е",
+	"\x82е\xd0",
+	"\x82ек",
+	"\x82и",
+	"\x82и\xd0",
+	"\x82им",
+	"\x82о",
+	"\x82о\xd1",
+	"\x82ор",
+	"\x82от",
+	"\x82п",
+	"\x82п\xd0",
+	"\x82пе",
+	"\x82\xd1",
+	"\x82р",
+	"\x82р\xd0",
+	"\x82ра",
+	"\x82ро",
+	"\x82р\xd1",
+	"\x82ры",
+	"\x83",
+	"\x83\xd0",
+	"\x83в",
+	"\x83в\xd0",
+	"\x83ве",
+	"\x83к",
+	"\x83к\xd0",
+	"\x83кв",
+	"\x83м",
+	"\x83м\xd0",
+	"\x83мн",
+	"\x84",
+	"\x84\xd0",
+	"\x84и",
+	"\x84и\xd0",
+	"\x84ил",
+	"\x84\xd1",
+	"\x84р",
+	"\x84р\xd0",
+	"\x84ра",
+	"\x87",
+	"\x87\xd0",
+	"\x87а",
+	"\x87а\xd1",
+	"\x87ас",
+	"\x87ат",
+	"\x87е",
+	"\x87е\xd0",
+	"\x87ен",
+	"\x8b",
+	"\x8b\xd0",
+	"\x8bв",
+	"\x8bв\xd0",
+	"\x8bва",
+	"\x8bвк",
+	"\x8bк",
+	"\x8bк\xd0",
+	"\x8bко",
+	"\x8d",
+	"\x8d\xd1",
+	"\x8dт",
+	"\x8dт\xd0",
+	"\x8dти",
+	"\x8f",
+	"\x8f\xd0",
+	"\x8fз",
+	"\x8fз\xd1",
+	"\x8fзы",
+	"\x9e",
+	"\x9e\xd0",
+	"\x9eп",
+	"\x9eп\xd1",
+	"\x9eпр",
+	"\xa0",
+	"\xa0\xd0",
+	"\xa0а",
+	"\xa0а\xd0",
+	"\xa0ан",
+	"\xa2",
+	"\xa2\xd0",
+	"\xa2а",
+	"\xa2а_",
+	"\xa2а__",
+	"\xa5",
+	"\xa5\xd0",
+	"\xa5о",
+	"\xa5о\xd1",
+	"\xa5ор",
+	"\xb0",
+	"\xb0\xd0",
+	"\xb0б",
+	"\xb0б\xd0",
+	"\xb0би",
+	"\xb0бо",
+	"\xb0в",
+	"\xb0в\xd0",
+	"\xb0вн",
+	"\xb0ж",
+	"\xb0ж\xd0",
+	"\xb0же",
+	"\xb0з",
+	"\xb0з\xd1",
+	"\xb0зу",
+	"\xb0зы",
+	"\xb0к",
+	"\xb0к\xd1",
+	"\xb0кт",
+	"\xb0л",
+	"\xb0л\xd0",
+	"\xb0ли",
+	"\xb0м",
+	"\xb0м\xd0",
+	"\xb0мм",
+	"\xb0м\xd1",
+	"\xb0мы",
+	"\xb0н",
+	"\xb0н\xd0",
+	"\xb0на",
+	"\xb0нж",
+	"\xb0ни",
+	"\xb0нн",
+	"\xb0\xd1",
+	"\xb0с",
+	"\xb0с\xd1",
+	"\xb0ст",
+	"\xb0т",
+	"\xb0т\xd0",
+	"\xb0те",
+	"\xb0то",
+	"\xb1",
+	"\xb1\xd0",
+	"\xb1и",
+	"\xb1и\xd0",
+	"\xb1ил",
+	"\xb1о",
+	"\xb1о\xd0",
+	"\xb1ол",
+	"\xb1о\xd1",
+	"\xb1от",
+	"\xb1\xd1",
+	"\xb1р",
+	"\xb1р\xd0",
+	"\xb1ра",
+	"\xb1у",
+	"\xb1у\xd0",
+	"\xb1ук",
+	"\xb2",
+	"\xb2\xd0",
+	"\xb2а",
+	"\xb2а\xd0",
+	"\xb2ае",
+	"\xb2а\xd1",
+	"\xb2ат",
+	"\xb2в",
+	"\xb2в\xd0",
+	"\xb2ве",
+	"\xb2е",
+	"\xb2е\xd0",
+	"\xb2еб",
+	"\xb2ед",
+	"\xb2ен",
+	"\xb2е\xd1",
+	"\xb2ер",
+	"\xb2ес",
+	"\xb2н",
+	"\xb2н\xd0",
+	"\xb2ни",
+	"\xb2\xd1",
+	"\xb2с",
+	"\xb2с\xd0",
+	"\xb2се",
+	"\xb2т",
+	"\xb2т\xd0",
+	"\xb2то",
+	"\xb3",
+	"\xb3\xd1",
+	"\xb3р",
+	"\xb3р\xd0",
+	"\xb3ра",
+	"\xb4",
+	"\xb4\xd0",
+	"\xb4а",
+	"\xb4а\xd0",
+	"\xb4аж",
+	"\xb4ан",
+	"\xb4е",
+	"\xb4е\xd0",
+	"\xb4ел",
+	"\xb4е\xd1",
+	"\xb4ея",
+	"\xb4к",
+	"\xb4к\xd0",
+	"\xb4ки",
+	"\xb4л",
+	"\xb4л\xd1",
+	"\xb4ля",
+	"\xb4н",
+	"\xb4н\xd0",
+	"\xb4не",
+	"\xb4но",
+	"\xb4о",
+	"\xb4о\xd0",
+	"\xb4ов",
+	"\xb4о\xd1",
+	"\xb4ос",
+	"\xb4\xd1",
+	"\xb4ё",
+	"\xb4ё\xd0",
+	"\xb4ён",
+	"\xb5",
+	"\xb5\xd0",
+	"\xb5г",
+	"\xb5г\xd0",
+	"\xb5го",
+	"\xb5д",
+	"\xb5д\xd0",
+	"\xb5де",
+	"\xb5дк",
+	"\xb5дн",
+	"\xb5до",
+	"\xb5д\xd1",
+	"\xb5дё",
+	"\xb5к",
+	"\xb5к\xd1",
+	"\xb5кс",
+	"\xb5н",
+	"\xb5н\xd0",
+	"\xb5нн",
+	"\xb5\xd1",
+	"\xb5р",
+	"\xb5р\xd0",
+	"\xb5ре",
+	"\xb5с",
+	"\xb5с\xd1",
+	"\xb5ст",
+	"\xb5ч",
+	"\xb5ч\xd0",
+	"\xb5ча",
+	"\xb6",
+	"\xb6\xd0",
+	"\xb6е",
+	"\xb6е_",
+	"\xb6е__",
+	"\xb6и",
+	"\xb6и\xd1",
+	"\xb6ир",
+	"\xb7",
+	"\xb7\xd0",
+	"\xb7а",
+	"\xb7а\xd1",
+	"\xb7ат",
+	"\xb7в",
+	"\xb7в\xd0",
+	"\xb7ве",
+	"\xb7\xd1",
+	"\xb7у",
+	"\xb7у\xd0",
+	"\xb7ум",
+	"\xb7ы",
+	"\xb7ы\xd0",
+	"\xb7ыв",
+	"\xb7ык",
+	"\xb8",
+	"\xb8\xd0",
+	"\xb8д",
+	"\xb8д\xd0",
+	"\xb8де",
+	"\xb8з",
+	"\xb8з\xd0",
+	"\xb8зв",
+	"\xb8зи",
+	"\xb8л",
+	"\xb8л\xd1",
+	"\xb8ль",
+	"\xb8\xd1",
+	"\xb8р",
+	"\xb8р\xd0",
+	"\xb8ра",
+	"\xb8с",
+	"\xb8с\xd0",
+	"\xb8ск",
+	"\xb8с\xd1",
+	"\xb8ст",
+	"\xba",
+	"\xba\xd0",
+	"\xbaв",
+	"\xbaв\xd0",
+	"\xbaве",
+	"\xbaн",
+	"\xbaн\xd0",
+	"\xbaни",
+	"\xbaо",
+	"\xbaо\xd0",
+	"\xbaом",
+	"\xbaо\xd1",
+	"\xbaор",
+	"\xbaот",
+	"\xba\xd1",
+	"\xbaс",
+	"\xbaс\xd1",
+	"\xbaст",
+	"\xbb",
+	"\xbb\xd0",
+	"\xbbе",
+	"\xbbе\xd0",
+	"\xbbед",
+	"\xbbи",
+	"\xbbи\xd0",
+	"\xbbиз",
+	"\xbb\xd1",
+	"\xbbь",
+	"\xbbь\xd1",
+	"\xbbьш",
+	"\xbc",
+	"\xbc\xd0",
+	"\xbcм",
+	"\xbcм\xd0",
+	"\xbcма",
+	"\xbcн",
+	"\xbcн\xd0",
+	"\xbcно",
+	"\xbcо",
+	"\xbcо\xd0",
+	"\xbcож",
+	"\xbcп",
+	"\xbcп\xd0",
+	"\xbcпа",
+	"\xbd",
+	"\xbd\xd0",
+	"\xbdа",
+	"\xbdа_",
+	"\xbdа__",
+	"\xbdа\xd0",
+	"\xbdаз",
+	"\xbdал",
+	"\xbdж",
+	"\xbdж\xd0",
+	"\xbdжи",
+	"\xbdи",
+	"\xbdи\xd0",
+	"\xbdив",
+	"\xbdиг",
+	"\xbdн",
+	"\xbdн\xd0",
+	"\xbdно",
+	"\xbdо",
+	"\xbdо\xd0",
+	"\xbdов",
+	"\xbdог",
+	"\xbe",
+	"\xbe\xd0",
+	"\xbeб",
+	"\xbeб\xd1",
+	"\xbeбр",
+	"\xbeв",
+	"\xbeв\xd0",
+	"\xbeва",
+	"\xbeв\xd1",
+	"\xbeвс",
+	"\xbeвт",
+	"\xbeвы",
+	"\xbeг",
+	"\xbeг\xd0",
+	"\xbeги",
+	"\xbeд",
+	"\xbeд\xd0",
+	"\xbeдн",
+	"\xbeж",
+	"\xbeж\xd0",
+	"\xbeже",
+	"\xbeи",
+	"\xbeи\xd1",
+	"\xbeис",
+	"\xbeл",
+	"\xbeл\xd1",
+	"\xbeль",
+	"\xbeм",
+	"\xbeм\xd0",
+	"\xbeмп",
+	"\xbeп",
+	"\xbeп\xd0",
+	"\xbeпи",
+	"\xbeп\xd1",
+	"\xbeпр",
+	"\xbe\xd1",
+	"\xbeр",
+	"\xbeр\xd0",
+	"\xbeро",
+	"\xbeр\xd1",
+	"\xbeря",
+	"\xbeс",
+	"\xbeс\xd0",
+	"\xbeсл",
+	"\xbeс\xd1",
+	"\xbeст",
+	"\xbeт",
+	"\xbeт_",
+	"\xbeт__",
+	"\xbeт\xd0",
+	"\xbeта",
+	"\xbeтк",
+	"\xbeто",
+	"\xbeтп",
+	"\xbeт\xd1",
+	"\xbeтр",
+	"\xbeф",
+	"\xbeф\xd0",
+	"\xbeфи",
+	"\xbeч",
+	"\xbeч\xd0",
+	"\xbeче",
+	"\xbf",
+	"\xbf\xd0",
+	"\xbfа",
+	"\xbfа\xd0",
+	"\xbfак",
+	"\xbfе",
+	"\xbfе\xd1",
+	"\xbfеч",
+	"\xbfи",
+	"\xbfи\xd1",
+	"\xbfир",
+	"\xbfо",
+	"\xbfо\xd0",
+	"\xbfов",
+	"\xbfои",
+	"\xbfо\xd1",
+	"\xbfос",
+	"\xbf\xd1",
+	"\xbfр",
+	"\xbfр\xd0",
+	"\xbfре",
+	"\xbfро",
+	"\xd0",
+	"О",
+	"О\xd0",
+	"Оп",
+	"Оп\xd1",
+	"Р",
+	"Р\xd0",
+	"Ра",
+	"Ра\xd0",
+	"Т",
+	"Т\xd0",
+	"Та",
+	"Та_",
+	"Х",
+	"Х\xd0",
+	"Хо",
+	"Хо\xd1",
+	"а",
+	"а\xd0",
+	"аб",
+	"аб\xd0",
+	"ав",
+	"ав\xd0",
+	"аж",
+	"аж\xd0",
+	"аз",
+	"аз\xd1",
+	"ак",
+	"ак\xd1",
+	"ал",
+	"ал\xd0",
+	"ам",
+	"ам\xd0",
+	"ам\xd1",
+	"ан",
+	"ан\xd0",
+	"а\xd1",
+	"ас",
+	"ас\xd1",
+	"ат",
+	"ат\xd0",
+	"аю",
+	"аю\xd1",
+	"б",
+	"б\xd0",
+	"би",
+	"би\xd0",
+	"бо",
+	"бо\xd0",
+	"бо\xd1",
+	"б\xd1",
+	"бр",
+	"бр\xd0",
+	"бу",
+	"бу\xd0",
+	"в",
+	"в_",
+	"в__",
+	"в___",
+	"в\xd0",
+	"ва",
+	"ва\xd0",
+	"ва\xd1",
+	"вв",
+	"вв\xd0",
+	"ве",
+	"ве\xd0",
+	"ве\xd1",
+	"вн",
+	"вн\xd0",
+	"в\xd1",
+	"вс",
+	"вс\xd0",
+	"вт",
+	"вт\xd0",
+	"вы",
+	"вы\xd0",
+	"г",
+	"г\xd0",
+	"го",
+	"го_",
+	"г\xd1",
+	"гр",
+	"гр\xd0",
+	"д",
+	"д\xd0",
+	"да",
+	"да\xd0",
+	"де",
+	"де\xd0",
+	"де\xd1",
+	"дк",
+	"дк\xd0",
+	"дл",
+	"дл\xd1",
+	"дн",
+	"дн\xd0",
+	"до",
+	"до\xd0",
+	"до\xd1",
+	"д\xd1",
+	"дё",
+	"дё\xd0",
+	"е",
+	"е\xd0",
+	"еб",
+	"еб_",
+	"ег",
+	"ег\xd0",
+	"ед",
+	"ед\xd0",
+	"ед\xd1",
+	"ек",
+	"ек\xd1",
+	"ел",
+	"ел\xd0",
+	"ен",
+	"ен\xd0",
+	"ен\xd1",
+	"е\xd1",
+	"ер",
+	"ер\xd0",
+	"ес",
+	"ес\xd1",
+	"еч",
+	"еч\xd0",
+	"ж",
+	"ж\xd0",
+	"же",
+	"же_",
+	"же\xd1",
+	"жи",
+	"жи\xd1",
+	"з",
+	"з\xd0",
+	"за",
+	"за\xd1",
+	"зв",
+	"зв\xd0",
+	"зи",
+	"зи\xd1",
+	"з\xd1",
+	"зу",
+	"зу\xd0",
+	"зы",
+	"зы\xd0",
+	"и",
+	"и_",
+	"и__",
+	"и___",
+	"и\xd0",
+	"иг",
+	"иг\xd0",
+	"ид",
+	"ид\xd0",
+	"из",
+	"из\xd0",
+	"ил",
+	"ил\xd1",
+	"и\xd1",
+	"ир",
+	"ир\xd0",
+	"ис",
+	"ис\xd0",
+	"ис\xd1",
+	"к",
+	"к_",
+	"к__",
+	"к___",
+	"к\xd0",
+	"кв",
+	"кв\xd0",
+	"кн",
+	"кн\xd0",
+	"ко",
+	"ко\xd0",
+	"ко\xd1",
+	"к\xd1",
+	"кс",
+	"кс\xd1",
+	"л",
+	"л\xd0",
+	"ле",
+	"ле\xd0",
+	"ли",
+	"ли\xd0",
+	"л\xd1",
+	"ль",
+	"ль\xd1",
+	"ля",
+	"ля_",
+	"м",
+	"м\xd0",
+	"мм",
+	"мм\xd0",
+	"мн",
+	"мн\xd0",
+	"мо",
+	"мо\xd0",
+	"мп",
+	"мп\xd0",
+	"м\xd1",
+	"мы",
+	"мы\xd0",
+	"мы\xd1",
+	"н",
+	"н\xd0",
+	"на",
+	"на_",
+	"на\xd0",
+	"нж",
+	"нж\xd0",
+	"ни",
+	"ни\xd0",
+	"нн",
+	"нн\xd0",
+	"но",
+	"но\xd0",
+	"о",
+	"о\xd0",
+	"об",
+	"об\xd1",
+	"ов",
+	"ов\xd0",
+	"ов\xd1",
+	"ог",
+	"ог\xd0",
+	"од",
+	"од\xd0",
+	"ож",
+	"ож\xd0",
+	"ои",
+	"ои\xd1",
+	"ол",
+	"ол\xd1",
+	"ом",
+	"ом\xd0",
+	"оп",
+	"оп\xd0",
+	"оп\xd1",
+	"о\xd1",
+	"ор",
+	"ор\xd0",
+	"ор\xd1",
+	"ос",
+	"ос\xd0",
+	"ос\xd1",
+	"от",
+	"от_",
+	"от\xd0",
+	"от\xd1",
+	"оф",
+	"оф\xd0",
+	"оч",
+	"оч\xd0",
+	"ош",
+	"ош\xd0",
+	"п",
+	"п\xd0",
+	"па",
+	"па\xd0",
+	"пе",
+	"пе\xd1",
+	"пи",
+	"пи\xd1",
+	"по",
+	"по\xd0",
+	"по\xd1",
+	"п\xd1",
+	"пр",
+	"пр\xd0",
+	"\xd1",
+	"р",
+	"р\xd0",
+	"ра",
+	"ра\xd0",
+	"ра\xd1",
+	"ре",
+	"ре\xd0",
+	"ро",
+	"ро\xd0",
+	"ро\xd1",
+	"р\xd1",
+	"ры",
+	"ры\xd0",
+	"ря",
+	"ря\xd1",
+	"с",
+	"с_",
+	"с__",
+	"с___",
+	"с\xd0",
+	"са",
+	"са\xd0",
+	"се",
+	"се\xd0",
+	"си",
+	"си\xd1",
+	"сл",
+	"сл\xd0",
+	"со",
+	"со_",
+	"с\xd1",
+	"ср",
+	"ср\xd0",
+	"ст",
+	"ст\xd0",
+	"ст\xd1",
+	"т",
+	"т\xd0",
+	"та",
+	"та\xd0",
+	"та\xd1",
+	"те",
+	"те\xd0",
+	"ти",
+	"ти_",
+	"ти\xd0",
+	"то",
+	"то\xd1",
+	"тп",
+	"тп\xd0",
+	"т\xd1",
+	"тр",
+	"тр\xd0",
+	"тр\xd1",
+	"у",
+	"у\xd0",
+	"ув",
+	"ув\xd0",
+	"ук",
+	"ук\xd0",
+	"ум",
+	"ум\xd0",
+	"ф",
+	"ф\xd0",
+	"фи",
+	"фи\xd0",
+	"ф\xd1",
+	"фр",
+	"фр\xd0",
+	"ч",
+	"ч\xd0",
+	"ча",
+	"ча\xd1",
+	"че",
+	"че\xd0",
+	"ы",
+	"ы\xd0",
+	"ыв",
+	"ыв\xd0",
+	"ык",
+	"ык\xd0",
+	"ь",
+	"ь\xd1",
+	"ьш",
+	"ьш\xd0",
+	"э",
+	"э\xd1",
+	"эт",
+	"эт\xd0",
+	"я",
+	"я\xd0",
+	"яз",
+	"яз\xd1",
+	"ё",
+	"ё\xd0",
+	"ён",
+	"ён\xd0",
+}
+
+var russianRanks = []uint16{
+	566,
+	6,
+	12,
+	565,
+	564,
+	5,
+	1082,
+	1081,
+	1080,
+	1079,
+	1078,
+	318,
+	226,
+	563,
+	95,
+	1077,
+	1076,
+	1075,
+	94,
+	93,
+	562,
+	317,
+	58,
+	115,
+	11,
+	316,
+	43,
+	315,
+	1074,
+	1073,
+	561,
+	560,
+	225,
+	559,
+	558,
+	557,
+	4,
+	1072,
+	1071,
+	1070,
+	1069,
+	1068,
+	1067,
+	1066,
+	1065,
+	1064,
+	1063,
+	314,
+	1062,
+	556,
+	224,
+	555,
+	554,
+	553,
+	552,
+	92,
+	91,
+	1061,
+	1060,
+	1059,
+	1058,
+	1057,
+	1056,
+	90,
+	157,
+	551,
+	89,
+	1055,
+	114,
+	550,
+	549,
+	313,
+	312,
+	57,
+	156,
+	113,
+	112,
+	155,
+	1054,
+	10,
+	311,
+	310,
+	42,
+	309,
+	154,
+	153,
+	308,
+	307,
+	1053,
+	1052,
+	1051,
+	1050,
+	548,
+	547,
+	546,
+	545,
+	223,
+	222,
+	544,
+	543,
+	542,
+	541,
+	3,
+	1049,
+	1048,
+	1047,
+	1046,
+	1045,
+	1044,
+	1043,
+	1042,
+	1041,
+	1040,
+	1039,
+	1038,
+	1037,
+	1036,
+	1035,
+	306,
+	1034,
+	1033,
+	540,
+	539,
+	221,
+	538,
+	537,
+	536,
+	1032,
+	1031,
+	535,
+	534,
+	533,
+	88,
+	87,
+	532,
+	220,
+	1030,
+	1029,
+	1028,
+	1027,
+	1026,
+	1025,
+	1024,
+	1023,
+	1022,
+	1021,
+	86,
+	152,
+	151,
+	531,
+	1020,
+	1019,
+	85,
+	1018,
+	1017,
+	111,
+	1016,
+	150,
+	530,
+	529,
+	1015,
+	1014,
+	305,
+	304,
+	528,
+	1013,
+	56,
+	149,
+	527,
+	1012,
+	526,
+	110,
+	148,
+	1011,
+	109,
+	147,
+	146,
+	1010,
+	1009,
+	9,
+	303,
+	302,
+	525,
+	1008,
+	41,
+	301,
+	300,
+	145,
+	524,
+	523,
+	1007,
+	144,
+	522,
+	299,
+	298,
+	297,
+	296,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	1001,
+	521,
+	520,
+	519,
+	518,
+	517,
+	516,
+	219,
+	218,
+	217,
+	515,
+	514,
+	513,
+	512,
+	511,
+	15,
+	20,
+	55,
+	66,
+	1000,
+	510,
+	216,
+	509,
+	999,
+	998,
+	997,
+	215,
+	214,
+	295,
+	996,
+	108,
+	995,
+	994,
+	143,
+	294,
+	993,
+	992,
+	508,
+	507,
+	506,
+	505,
+	25,
+	84,
+	504,
+	503,
+	502,
+	991,
+	990,
+	989,
+	501,
+	500,
+	499,
+	988,
+	987,
+	986,
+	985,
+	984,
+	983,
+	48,
+	498,
+	497,
+	496,
+	65,
+	107,
+	495,
+	494,
+	982,
+	981,
+	213,
+	293,
+	980,
+	28,
+	54,
+	493,
+	979,
+	978,
+	977,
+	976,
+	292,
+	291,
+	290,
+	975,
+	974,
+	973,
+	289,
+	288,
+	492,
+	972,
+	491,
+	490,
+	489,
+	142,
+	141,
+	287,
+	971,
+	488,
+	487,
+	486,
+	212,
+	211,
+	970,
+	969,
+	968,
+	485,
+	484,
+	483,
+	967,
+	966,
+	965,
+	482,
+	964,
+	963,
+	962,
+	961,
+	960,
+	959,
+	958,
+	957,
+	210,
+	209,
+	286,
+	285,
+	481,
+	956,
+	955,
+	954,
+	953,
+	284,
+	283,
+	480,
+	479,
+	952,
+	951,
+	950,
+	949,
+	948,
+	478,
+	477,
+	476,
+	475,
+	474,
+	208,
+	207,
+	206,
+	205,
+	204,
+	947,
+	946,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	933,
+	932,
+	931,
+	930,
+	929,
+	928,
+	19,
+	26,
+	473,
+	472,
+	927,
+	926,
+	471,
+	470,
+	469,
+	925,
+	924,
+	923,
+	468,
+	467,
+	922,
+	921,
+	920,
+	919,
+	918,
+	917,
+	916,
+	915,
+	203,
+	466,
+	465,
+	464,
+	463,
+	202,
+	201,
+	914,
+	913,
+	912,
+	911,
+	140,
+	462,
+	461,
+	460,
+	282,
+	281,
+	459,
+	910,
+	83,
+	280,
+	909,
+	908,
+	907,
+	458,
+	906,
+	905,
+	904,
+	903,
+	200,
+	457,
+	456,
+	455,
+	454,
+	453,
+	452,
+	47,
+	64,
+	451,
+	902,
+	901,
+	900,
+	899,
+	898,
+	897,
+	896,
+	139,
+	279,
+	895,
+	894,
+	893,
+	450,
+	892,
+	891,
+	449,
+	448,
+	447,
+	446,
+	890,
+	889,
+	888,
+	887,
+	886,
+	885,
+	445,
+	444,
+	443,
+	442,
+	441,
+	34,
+	38,
+	440,
+	439,
+	884,
+	883,
+	278,
+	438,
+	437,
+	882,
+	881,
+	880,
+	879,
+	878,
+	199,
+	198,
+	197,
+	436,
+	435,
+	877,
+	876,
+	434,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	33,
+	53,
+	867,
+	866,
+	865,
+	106,
+	138,
+	433,
+	864,
+	863,
+	862,
+	861,
+	860,
+	277,
+	276,
+	275,
+	859,
+	858,
+	857,
+	196,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	432,
+	431,
+	430,
+	429,
+	428,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	72,
+	427,
+	844,
+	843,
+	842,
+	841,
+	840,
+	839,
+	105,
+	838,
+	837,
+	836,
+	137,
+	136,
+	835,
+	195,
+	71,
+	194,
+	834,
+	833,
+	832,
+	426,
+	425,
+	831,
+	830,
+	829,
+	828,
+	827,
+	193,
+	826,
+	825,
+	824,
+	274,
+	823,
+	822,
+	424,
+	423,
+	70,
+	82,
+	821,
+	820,
+	819,
+	818,
+	817,
+	816,
+	135,
+	815,
+	814,
+	192,
+	273,
+	813,
+	422,
+	421,
+	420,
+	419,
+	272,
+	418,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	803,
+	191,
+	190,
+	802,
+	801,
+	800,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	52,
+	51,
+	271,
+	790,
+	789,
+	417,
+	788,
+	787,
+	786,
+	785,
+	784,
+	270,
+	269,
+	416,
+	783,
+	415,
+	414,
+	413,
+	412,
+	411,
+	782,
+	781,
+	8,
+	37,
+	410,
+	409,
+	408,
+	189,
+	780,
+	779,
+	268,
+	778,
+	777,
+	776,
+	775,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	407,
+	757,
+	756,
+	755,
+	754,
+	24,
+	134,
+	188,
+	187,
+	753,
+	752,
+	267,
+	751,
+	750,
+	406,
+	405,
+	63,
+	749,
+	748,
+	81,
+	747,
+	266,
+	746,
+	404,
+	403,
+	402,
+	745,
+	744,
+	743,
+	742,
+	741,
+	740,
+	46,
+	69,
+	739,
+	738,
+	737,
+	401,
+	400,
+	399,
+	736,
+	735,
+	734,
+	133,
+	265,
+	398,
+	733,
+	397,
+	396,
+	264,
+	263,
+	262,
+	395,
+	732,
+	1,
+	731,
+	730,
+	729,
+	728,
+	727,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	717,
+	716,
+	13,
+	23,
+	394,
+	393,
+	392,
+	391,
+	715,
+	714,
+	132,
+	131,
+	713,
+	712,
+	711,
+	710,
+	186,
+	390,
+	389,
+	185,
+	184,
+	104,
+	388,
+	387,
+	261,
+	260,
+	709,
+	708,
+	80,
+	259,
+	707,
+	706,
+	386,
+	705,
+	704,
+	183,
+	385,
+	384,
+	383,
+	382,
+	32,
+	381,
+	380,
+	379,
+	62,
+	378,
+	703,
+	702,
+	701,
+	700,
+	130,
+	258,
+	377,
+	376,
+	375,
+	257,
+	699,
+	698,
+	697,
+	696,
+	695,
+	694,
+	256,
+	693,
+	692,
+	691,
+	374,
+	373,
+	372,
+	31,
+	36,
+	371,
+	370,
+	255,
+	369,
+	690,
+	689,
+	688,
+	182,
+	181,
+	368,
+	367,
+	366,
+	687,
+	686,
+	685,
+	684,
+	683,
+	22,
+	27,
+	682,
+	681,
+	680,
+	679,
+	103,
+	129,
+	678,
+	254,
+	253,
+	365,
+	364,
+	252,
+	363,
+	677,
+	180,
+	676,
+	675,
+	674,
+	673,
+	362,
+	361,
+	251,
+	250,
+	360,
+	672,
+	671,
+	670,
+	669,
+	68,
+	249,
+	668,
+	667,
+	666,
+	665,
+	664,
+	663,
+	102,
+	662,
+	661,
+	128,
+	127,
+	30,
+	126,
+	125,
+	124,
+	101,
+	660,
+	659,
+	658,
+	657,
+	359,
+	358,
+	357,
+	356,
+	179,
+	656,
+	655,
+	248,
+	654,
+	355,
+	50,
+	653,
+	652,
+	651,
+	79,
+	650,
+	649,
+	648,
+	647,
+	123,
+	646,
+	178,
+	247,
+	246,
+	245,
+	78,
+	354,
+	645,
+	644,
+	643,
+	642,
+	122,
+	641,
+	640,
+	177,
+	176,
+	100,
+	175,
+	639,
+	638,
+	637,
+	636,
+	635,
+	634,
+	633,
+	632,
+	353,
+	352,
+	631,
+	630,
+	40,
+	39,
+	244,
+	629,
+	351,
+	628,
+	627,
+	243,
+	242,
+	350,
+	349,
+	174,
+	173,
+	7,
+	35,
+	348,
+	347,
+	172,
+	626,
+	241,
+	625,
+	624,
+	623,
+	622,
+	621,
+	620,
+	619,
+	618,
+	617,
+	616,
+	615,
+	614,
+	346,
+	613,
+	612,
+	18,
+	99,
+	171,
+	345,
+	240,
+	611,
+	344,
+	61,
+	610,
+	77,
+	343,
+	609,
+	608,
+	607,
+	606,
+	605,
+	604,
+	45,
+	67,
+	603,
+	602,
+	342,
+	341,
+	601,
+	600,
+	121,
+	239,
+	340,
+	238,
+	237,
+	236,
+	2,
+	14,
+	17,
+	49,
+	60,
+	599,
+	170,
+	169,
+	76,
+	339,
+	120,
+	235,
+	338,
+	337,
+	598,
+	597,
+	16,
+	234,
+	233,
+	232,
+	75,
+	336,
+	335,
+	596,
+	595,
+	334,
+	333,
+	594,
+	593,
+	592,
+	591,
+	44,
+	332,
+	331,
+	59,
+	98,
+	168,
+	21,
+	29,
+	330,
+	590,
+	589,
+	97,
+	96,
+	329,
+	588,
+	587,
+	231,
+	230,
+	328,
+	327,
+	119,
+	118,
+	229,
+	326,
+	167,
+	166,
+	586,
+	585,
+	325,
+	324,
+	584,
+	583,
+	323,
+	582,
+	581,
+	580,
+	579,
+	578,
+	577,
+	117,
+	116,
+	165,
+	164,
+	576,
+	575,
+	74,
+	73,
+	228,
+	227,
+	163,
+	162,
+	574,
+	573,
+	572,
+	571,
+	322,
+	321,
+	320,
+	319,
+	161,
+	160,
+	159,
+	158,
+	570,
+	569,
+	568,
+	567,
+}
+
+var turkishTokens = []string{
+	"A",
+	"Ay",
+	"Ayn",
+	"Ayn\xc4",
+	"Aynı",
+	"B",
+	"Bu",
+	"Bu_",
+	"Bu__",
+	"Bu___",
+	"D",
+	"Di",
+	"Dil",
+	"Dil_",
+	"Dil__",
+	"_A",
+	"_Ay",
+	"_Ayn",
+	"_Ayn\xc4",
+	"_B",
+	"_Bu",
+	"_Bu_",
+	"_Bu__",
+	"_D",
+	"_Di",
+	"_Dil",
+	"_Dil_",
+	"__A",
+	"__Ay",
+	"__Ayn",
+	"__B",
+	"__Bu",
+	"__Bu_",
+	"__D",
+	"__Di",
+	"__Dil",
+	"___A",
+	"___Ay",
+	"___B",
+	"___Bu",
+	"___D",
+	"___Di",
+	"____A",
+	"____B",
+	"____D",
+	"____a",
+	"____b",
+	"____c",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____h",
+	"____i",
+	"____k",
+	"____m",
+	"____n",
+	"____o",
+	"____p",
+	"____s",
+	"____t",
+	"____v",
+	"____w",
+	"____y",
+	"____\xc3",
+	"____\xc4",
+	"___a",
+	"___ad",
+	"___ar",
+	"___az",
+	"___b",
+	"___bi",
+	"___bu",
+	"___b\xc3",
+	"___c",
+	"___c\xc3",
+	"___d",
+	"___da",
+	"___de",
+	"___di",
+	"___do",
+	"___e",
+	"___ed",
+	"___f",
+	"___fi",
+	"___g",
+	"___ge",
+	"___gr",
+	"___g\xc3",
+	"___h",
+	"___ha",
+	"___i",
+	"___in",
+	"___iz",
+	"___i\xc3",
+	"___k",
+	"___ka",
+	"___ki",
+	"___ku",
+	"___k\xc3",
+	"___k\xc4",
+	"___m",
+	"___ma",
+	"___me",
+	"___n",
+	"___n_",
+	"___o",
+	"___ol",
+	"___p",
+	"___pa",
+	"___pr",
+	"___s",
+	"___sa",
+	"___si",
+	"___so",
+	"___s\xc4",
+	"___t",
+	"___ta",
+	"___te",
+	"___v",
+	"___ve",
+	"___w",
+	"___we",
+	"___y",
+	"___ya",
+	"___ye",
+	"___yo",
+	"___\xc3",
+	"___ç",
+	"___ö",
+	"___\xc4",
+	"___İ",
+	"__a",
+	"__ad",
+	"__ad\xc4",
+	"__ar",
+	"__ara",
+	"__az",
+	"__az_",
+	"__b",
+	"__bi",
+	"__bil",
+	"__bir",
+	"__bu",
+	"__bu_",
+	"__b\xc3",
+	"__bü",
+	"__c",
+	"__c\xc3",
+	"__cü",
+	"__d",
+	"__da",
+	"__da_",
+	"__dah",
+	"__day",
+	"__de",
+	"__de_",
+	"__di",
+	"__dil",
+	"__diz",
+	"__do",
+	"__do\xc4",
+	"__e",
+	"__ed",
+	"__ede",
+	"__f",
+	"__fi",
+	"__fik",
+	"__g",
+	"__ge",
+	"__ge\xc3",
+	"__gr",
+	"__gra",
+	"__g\xc3",
+	"__gü",
+	"__h",
+	"__ha",
+	"__har",
+	"__i",
+	"__in",
+	"__inc",
+	"__iz",
+	"__izi",
+	"__i\xc3",
+	"__iç",
+	"__k",
+	"__ka",
+	"__kar",
+	"__ki",
+	"__kit",
+	"__ku",
+	"__kul",
+	"__kut",
+	"__k\xc3",
+	"__kü",
+	"__k\xc4",
+	"__kı",
+	"__m",
+	"__ma",
+	"__mak",
+	"__me",
+	"__met",
+	"__n",
+	"__n_",
+	"__n__",
+	"__o",
+	"__ol",
+	"__olu",
+	"__p",
+	"__pa",
+	"__par",
+	"__pr",
+	"__pro",
+	"__s",
+	"__sa",
+	"__say",
+	"__si",
+	"__sis",
+	"__so",
+	"__son",
+	"__s\xc4",
+	"__sı",
+	"__t",
+	"__ta",
+	"__tan",
+	"__te",
+	"__tek",
+	"__v",
+	"__ve",
+	"__ve_",
+	"__ver",
+	"__w",
+	"__we",
+	"__web",
+	"__y",
+	"__ya",
+	"__ya_",
+	"__yaz",
+	"__ye",
+	"__yen",
+	"__yet",
+	"__yo",
+	"__yo\xc4",
+	"__\xc3",
+	"__ç",
+	"__ço",
+	"__ö",
+	"__ör",
+	"__\xc4",
+	"__İ",
+	"__İy",
+	"_a",
+	"_ad",
+	"_ad\xc4",
+	"_adı",
+	"_ar",
+	"_ara",
+	"_aram",
+	"_az",
+	"_az_",
+	"_az__",
+	"_b",
+	"_bi",
+	"_bil",
+	"_bile",
+	"_bili",
+	"_bir",
+	"_bir_",
+	"_bir\xc3",
+	"_bu",
+	"_bu_",
+	"_bu__",
+	"_b\xc3",
+	"_bü",
+	"_büy",
+	"_c",
+	"_c\xc3",
+	"_cü",
+	"_cüm",
+	"_d",
+	"_da",
+	"_da_",
+	"_da__",
+	"_dah",
+	"_daha",
+	"_day",
+	"_daya",
+	"_de",
+	"_de_",
+	"_de__",
+	"_di",
+	"_dil",
+	"_dil_",
+	"_dili",
+	"_diz",
+	"_dizi",
+	"_do",
+	"_do\xc4",
+	"_doğ",
+	"_e",
+	"_ed",
+	"_ede",
+	"_eder",
+	"_f",
+	"_fi",
+	"_fik",
+	"_fiki",
+	"_g",
+	"_ge",
+	"_ge\xc3",
+	"_geç",
+	"_gr",
+	"_gra",
+	"_gram",
+	"_g\xc3",
+	"_gü",
+	"_gün",
+	"_güv",
+	"_h",
+	"_ha",
+	"_har",
+	"_harf",
+	"_i",
+	"_in",
+	"_inc",
+	"_ince",
+	"_iz",
+	"_izi",
+	"_izin",
+	"_iziy",
+	"_i\xc3",
+	"_iç",
+	"_içi",
+	"_k",
+	"_ka",
+	"_kar",
+	"_kara",
+	"_kar\xc5",
+	"_ki",
+	"_kit",
+	"_kita",
+	"_ku",
+	"_kul",
+	"_kull",
+	"_kut",
+	"_kutu",
+	"_k\xc3",
+	"_kü",
+	"_kü\xc3",
+	"_k\xc4",
+	"_kı",
+	"_kıs",
+	"_m",
+	"_ma",
+	"_mak",
+	"_maku",
+	"_me",
+	"_met",
+	"_meti",
+	"_metn",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_o",
+	"_ol",
+	"_olu",
+	"_olu\xc5",
+	"_p",
+	"_pa",
+	"_par",
+	"_parm",
+	"_par\xc3",
+	"_pr",
+	"_pro",
+	"_prof",
+	"_s",
+	"_sa",
+	"_say",
+	"_sayf",
+	"_si",
+	"_sis",
+	"_sist",
+	"_so",
+	"_son",
+	"_sonr",
+	"_s\xc4",
+	"_sı",
+	"_sık",
+	"_sır",
+	"_t",
+	"_ta",
+	"_tan",
+	"_tan\xc4",
+	"_te",
+	"_tek",
+	"_tek_",
+	"_tekr",
+	"_v",
+	"_ve",
+	"_ve_",
+	"_ve__",
+	"_ver",
+	"_veri",
+	"_w",
+	"_we",
+	"_web",
+	"_web_",
+	"_y",
+	"_ya",
+	"_ya_",
+	"_ya__",
+	"_yaz",
+	"_yaz\xc4",
+	"_ye",
+	"_yen",
+	"_yeni",
+	"_yet",
+	"_yete",
+	"_yo",
+	"_yo\xc4",
+	"_yoğ",
+	"_\xc3",
+	"_ç",
+	"_ço",
+	"_çok",
+	"_ö",
+	"_ör",
+	"_örn",
+	"_ör\xc3",
+	"_\xc4",
+	"_İ",
+	"_İy",
+	"_İyi",
+	"a",
+	"a_",
+	"a__",
+	"a___",
+	"a____",
+	"ab",
+	"abi",
+	"abil",
+	"abili",
+	"ad",
+	"ad\xc4",
+	"adı",
+	"adı_",
+	"ah",
+	"aha",
+	"aha_",
+	"aha__",
+	"ak",
+	"ak_",
+	"ak__",
+	"ak___",
+	"aku",
+	"akul",
+	"akul_",
+	"al",
+	"ala",
+	"alay",
+	"alaya",
+	"am",
+	"am_",
+	"am__",
+	"am___",
+	"ama",
+	"ama_",
+	"ama__",
+	"aml",
+	"amla",
+	"amlar",
+	"an",
+	"an_",
+	"an__",
+	"an___",
+	"ana",
+	"ana_",
+	"ana__",
+	"and",
+	"anda",
+	"andan",
+	"an\xc4",
+	"anı",
+	"anıl",
+	"anım",
+	"anır",
+	"ap",
+	"ap_",
+	"ap__",
+	"ap___",
+	"ar",
+	"ar_",
+	"ar__",
+	"ar___",
+	"ara",
+	"arak",
+	"arak_",
+	"aram",
+	"arama",
+	"arar",
+	"ararl",
+	"arf",
+	"arf_",
+	"arf__",
+	"arl",
+	"arl\xc4",
+	"arlı",
+	"arm",
+	"arma",
+	"armak",
+	"ar\xc3",
+	"arç",
+	"arça",
+	"ar\xc4",
+	"arı",
+	"arı_",
+	"ar\xc5",
+	"arş",
+	"arş\xc4",
+	"as",
+	"as\xc4",
+	"ası",
+	"ası_",
+	"asın",
+	"ay",
+	"aya",
+	"ayan",
+	"ayan\xc4",
+	"ayar",
+	"ayara",
+	"ayf",
+	"ayfa",
+	"ayfas",
+	"az",
+	"az_",
+	"az__",
+	"az___",
+	"az\xc4",
+	"azı",
+	"azıl",
+	"azın",
+	"a\xc5",
+	"aş",
+	"aşt",
+	"aşt\xc4",
+	"b",
+	"b_",
+	"b__",
+	"b___",
+	"b____",
+	"bi",
+	"bil",
+	"bile",
+	"bile_",
+	"bili",
+	"bilin",
+	"bilir",
+	"bir",
+	"bir_",
+	"bir__",
+	"bir\xc3",
+	"birç",
+	"bu",
+	"bu_",
+	"bu__",
+	"bu___",
+	"b\xc3",
+	"bü",
+	"büy",
+	"büy\xc3",
+	"c",
+	"ce",
+	"ce_",
+	"ce__",
+	"ce___",
+	"cel",
+	"cele",
+	"celer",
+	"c\xc3",
+	"cü",
+	"cüm",
+	"cüml",
+	"d",
+	"da",
+	"da_",
+	"da__",
+	"da___",
+	"dah",
+	"daha",
+	"daha_",
+	"dan",
+	"dan_",
+	"dan__",
+	"day",
+	"daya",
+	"dayan",
+	"de",
+	"de_",
+	"de__",
+	"de___",
+	"der",
+	"der_",
+	"der__",
+	"di",
+	"dil",
+	"dil_",
+	"dil__",
+	"dili",
+	"dilin",
+	"dir",
+	"dir_",
+	"dir__",
+	"diz",
+	"dizi",
+	"dizil",
+	"do",
+	"do\xc4",
+	"doğ",
+	"doğr",
+	"d\xc4",
+	"dı",
+	"dı_",
+	"dı__",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"eb",
+	"eb_",
+	"eb__",
+	"eb___",
+	"ed",
+	"ede",
+	"eder",
+	"eder_",
+	"ek",
+	"ek_",
+	"ek__",
+	"ek___",
+	"ekl",
+	"ekle",
+	"ekler",
+	"ekr",
+	"ekra",
+	"ekrar",
+	"el",
+	"ele",
+	"eler",
+	"eler_",
+	"em",
+	"em_",
+	"em__",
+	"em___",
+	"emi",
+	"emi_",
+	"emi__",
+	"en",
+	"en_",
+	"en__",
+	"en___",
+	"eni",
+	"eni_",
+	"eni__",
+	"enl",
+	"enle",
+	"enle_",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"eri",
+	"eril",
+	"erile",
+	"erin",
+	"erinc",
+	"erind",
+	"erine",
+	"erini",
+	"erl",
+	"erle",
+	"erle_",
+	"erli",
+	"erlid",
+	"et",
+	"ete",
+	"eter",
+	"eteri",
+	"eti",
+	"etin",
+	"etin_",
+	"etn",
+	"etni",
+	"etni_",
+	"e\xc3",
+	"eç",
+	"eçe",
+	"eçer",
+	"e\xc4",
+	"eğ",
+	"eği",
+	"eğin",
+	"f",
+	"f_",
+	"f__",
+	"f___",
+	"f____",
+	"fa",
+	"fas",
+	"fas\xc4",
+	"fası",
+	"fi",
+	"fik",
+	"fiki",
+	"fikir",
+	"fil",
+	"fill",
+	"fille",
+	"g",
+	"ge",
+	"ge\xc3",
+	"geç",
+	"geçe",
+	"gr",
+	"gra",
+	"gram",
+	"gram_",
+	"graml",
+	"g\xc3",
+	"gü",
+	"gün",
+	"günl",
+	"güv",
+	"güve",
+	"h",
+	"ha",
+	"ha_",
+	"ha__",
+	"ha___",
+	"har",
+	"harf",
+	"harf_",
+	"i",
+	"i_",
+	"i__",
+	"i___",
+	"i____",
+	"id",
+	"idi",
+	"idir",
+	"idir_",
+	"ik",
+	"iki",
+	"ikir",
+	"ikir_",
+	"il",
+	"il_",
+	"il__",
+	"il___",
+	"ile",
+	"ile_",
+	"ile__",
+	"ilen",
+	"ilen_",
+	"iler",
+	"iler_",
+	"ileri",
+	"ili",
+	"ilin",
+	"ilin_",
+	"iline",
+	"ilir",
+	"ilir_",
+	"ill",
+	"ille",
+	"iller",
+	"in",
+	"in_",
+	"in__",
+	"in___",
+	"inc",
+	"ince",
+	"ince_",
+	"incel",
+	"ind",
+	"inde",
+	"inde_",
+	"ine",
+	"ine_",
+	"ine__",
+	"inen",
+	"inen_",
+	"ini",
+	"ini_",
+	"ini__",
+	"ir",
+	"ir_",
+	"ir__",
+	"ir___",
+	"ir\xc3",
+	"irç",
+	"irço",
+	"is",
+	"ist",
+	"iste",
+	"istem",
+	"it",
+	"ita",
+	"itap",
+	"itap_",
+	"iy",
+	"iyl",
+	"iyle",
+	"iyle_",
+	"iz",
+	"izi",
+	"izil",
+	"izile",
+	"izin",
+	"izini",
+	"iziy",
+	"iziyl",
+	"i\xc3",
+	"iç",
+	"içi",
+	"için",
+	"k",
+	"k_",
+	"k__",
+	"k___",
+	"k____",
+	"ka",
+	"kar",
+	"kara",
+	"karar",
+	"kar\xc5",
+	"karş",
+	"ki",
+	"kir",
+	"kir_",
+	"kir__",
+	"kit",
+	"kita",
+	"kitap",
+	"kl",
+	"kla",
+	"kla_",
+	"kla__",
+	"kle",
+	"kler",
+	"kleri",
+	"kl\xc4",
+	"klı",
+	"klık",
+	"kr",
+	"kra",
+	"krar",
+	"krar_",
+	"ku",
+	"kul",
+	"kul_",
+	"kul__",
+	"kull",
+	"kulla",
+	"kut",
+	"kutu",
+	"kutus",
+	"k\xc3",
+	"kü",
+	"kü\xc3",
+	"küç",
+	"k\xc4",
+	"kı",
+	"kıs",
+	"kısa",
+	"l",
+	"l_",
+	"l__",
+	"l___",
+	"l____",
+	"la",
+	"lan",
+	"lan_",
+	"lan__",
+	"lana",
+	"lana_",
+	"land",
+	"landa",
+	"lan\xc4",
+	"lanı",
+	"lar",
+	"lar\xc4",
+	"ları",
+	"lay",
+	"laya",
+	"layar",
+	"la\xc5",
+	"laş",
+	"laşt",
+	"le",
+	"le_",
+	"le__",
+	"le___",
+	"len",
+	"len_",
+	"len__",
+	"ler",
+	"ler_",
+	"ler__",
+	"leri",
+	"lerin",
+	"lerl",
+	"lerle",
+	"li",
+	"lid",
+	"lidi",
+	"lidir",
+	"lin",
+	"lin_",
+	"lin__",
+	"line",
+	"linen",
+	"lir",
+	"lir_",
+	"lir__",
+	"ll",
+	"lla",
+	"llan",
+	"llan\xc4",
+	"lle",
+	"ller",
+	"llerl",
+	"lu",
+	"lu\xc5",
+	"luş",
+	"luşt",
+	"l\xc3",
+	"lü",
+	"lük",
+	"lük_",
+	"l\xc4",
+	"lı",
+	"lı_",
+	"lı__",
+	"lık",
+	"lıkl",
+	"m",
+	"m_",
+	"m__",
+	"m___",
+	"m____",
+	"ma",
+	"ma_",
+	"ma__",
+	"ma___",
+	"mak",
+	"mak_",
+	"mak__",
+	"maku",
+	"makul",
+	"me",
+	"met",
+	"meti",
+	"metin",
+	"metn",
+	"metni",
+	"mi",
+	"mi_",
+	"mi__",
+	"mi___",
+	"ml",
+	"mla",
+	"mlar",
+	"mlar\xc4",
+	"mle",
+	"mle_",
+	"mle__",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"na_",
+	"na__",
+	"na___",
+	"nc",
+	"nce",
+	"nce_",
+	"nce__",
+	"ncel",
+	"ncele",
+	"nd",
+	"nda",
+	"ndan",
+	"ndan_",
+	"nde",
+	"nde_",
+	"nde__",
+	"ne",
+	"ne_",
+	"ne__",
+	"ne___",
+	"nek",
+	"nekl",
+	"nekle",
+	"nen",
+	"nen_",
+	"nen__",
+	"ne\xc4",
+	"neğ",
+	"neği",
+	"ni",
+	"ni_",
+	"ni__",
+	"ni___",
+	"nl",
+	"nle",
+	"nle_",
+	"nle__",
+	"nl\xc3",
+	"nlü",
+	"nlük",
+	"nr",
+	"nra",
+	"nra_",
+	"nra__",
+	"nt",
+	"nt\xc3",
+	"ntü",
+	"ntül",
+	"n\xc4",
+	"nı",
+	"nı_",
+	"nı__",
+	"nıl",
+	"nıla",
+	"nım",
+	"nıma",
+	"nın",
+	"nın_",
+	"nır",
+	"nır_",
+	"o",
+	"of",
+	"ofi",
+	"ofil",
+	"ofill",
+	"ok",
+	"ok_",
+	"ok__",
+	"ok___",
+	"ol",
+	"olu",
+	"olu\xc5",
+	"oluş",
+	"on",
+	"onr",
+	"onra",
+	"onra_",
+	"o\xc4",
+	"oğ",
+	"oğr",
+	"oğru",
+	"oğu",
+	"oğun",
+	"p",
+	"p_",
+	"p__",
+	"p___",
+	"p____",
+	"pa",
+	"par",
+	"parm",
+	"parma",
+	"par\xc3",
+	"parç",
+	"pr",
+	"pro",
+	"prof",
+	"profi",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"ra_",
+	"ra__",
+	"ra___",
+	"rab",
+	"rabi",
+	"rabil",
+	"rak",
+	"rak_",
+	"rak__",
+	"ral",
+	"rala",
+	"ralay",
+	"ram",
+	"ram_",
+	"ram__",
+	"rama",
+	"rama_",
+	"raml",
+	"ramla",
+	"rar",
+	"rar_",
+	"rar__",
+	"rarl",
+	"rarl\xc4",
+	"rf",
+	"rf_",
+	"rf__",
+	"rf___",
+	"ri",
+	"ril",
+	"rile",
+	"rilen",
+	"rin",
+	"rinc",
+	"rince",
+	"rind",
+	"rinde",
+	"rine",
+	"rine_",
+	"rini",
+	"rini_",
+	"rl",
+	"rle",
+	"rle_",
+	"rle__",
+	"rli",
+	"rlid",
+	"rlidi",
+	"rl\xc4",
+	"rlı",
+	"rlı_",
+	"rm",
+	"rma",
+	"rmak",
+	"rmak_",
+	"rn",
+	"rne",
+	"rnek",
+	"rnekl",
+	"rne\xc4",
+	"rneğ",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"ru",
+	"ru_",
+	"ru__",
+	"ru___",
+	"r\xc3",
+	"rç",
+	"rça",
+	"rças",
+	"rço",
+	"rçok",
+	"rü",
+	"rün",
+	"rünt",
+	"r\xc4",
+	"rı",
+	"rı_",
+	"rı__",
+	"r\xc5",
+	"rş",
+	"rş\xc4",
+	"rşı",
+	"s",
+	"sa",
+	"sa_",
+	"sa__",
+	"sa___",
+	"say",
+	"sayf",
+	"sayfa",
+	"si",
+	"sis",
+	"sist",
+	"siste",
+	"so",
+	"son",
+	"sonr",
+	"sonra",
+	"st",
+	"ste",
+	"stem",
+	"stem_",
+	"stemi",
+	"su",
+	"sun",
+	"suna",
+	"suna_",
+	"s\xc4",
+	"sı",
+	"sı_",
+	"sı__",
+	"sık",
+	"sık_",
+	"sıkl",
+	"sın",
+	"sın\xc4",
+	"sır",
+	"sıra",
+	"t",
+	"ta",
+	"tan",
+	"tan\xc4",
+	"tanı",
+	"tap",
+	"tap_",
+	"tap__",
+	"te",
+	"tek",
+	"tek_",
+	"tek__",
+	"tekr",
+	"tekra",
+	"tem",
+	"tem_",
+	"tem__",
+	"temi",
+	"temi_",
+	"ter",
+	"teri",
+	"terin",
+	"ti",
+	"tin",
+	"tin_",
+	"tin__",
+	"tn",
+	"tni",
+	"tni_",
+	"tni__",
+	"tu",
+	"tur",
+	"tura",
+	"turab",
+	"tus",
+	"tusu",
+	"tusun",
+	"t\xc3",
+	"tü",
+	"tül",
+	"tüle",
+	"t\xc4",
+	"tı",
+	"tır",
+	"tıra",
+	"tır\xc4",
+	"u",
+	"u_",
+	"u__",
+	"u___",
+	"u____",
+	"ul",
+	"ul_",
+	"ul__",
+	"ul___",
+	"ull",
+	"ulla",
+	"ullan",
+	"un",
+	"un_",
+	"un__",
+	"un___",
+	"una",
+	"una_",
+	"una__",
+	"ur",
+	"ura",
+	"urab",
+	"urabi",
+	"us",
+	"usu",
+	"usun",
+	"usuna",
+	"ut",
+	"utu",
+	"utus",
+	"utusu",
+	"u\xc5",
+	"uş",
+	"uşt",
+	"uştu",
+	"v",
+	"ve",
+	"ve_",
+	"ve__",
+	"ve___",
+	"ven",
+	"venl",
+	"venle",
+	"ver",
+	"veri",
+	"veril",
+	"w",
+	"we",
+	"web",
+	"web_",
+	"web__",
+	"y",
+	"ya",
+	"ya_",
+	"ya__",
+	"ya___",
+	"yan",
+	"yan\xc4",
+	"yanı",
+	"yar",
+	"yara",
+	"yarak",
+	"yaz",
+	"yaz\xc4",
+	"yazı",
+	"ye",
+	"yen",
+	"yeni",
+	"yeni_",
+	"yet",
+	"yete",
+	"yeter",
+	"yf",
+	"yfa",
+	"yfas",
+	"yfas\xc4",
+	"yi",
+	"yi_",
+	"yi__",
+	"yi___",
+	"yl",
+	"yle",
+	"yle_",
+	"yle__",
+	"yn",
+	"yn\xc4",
+	"ynı",
+	"ynı_",
+	"yo",
+	"yo\xc4",
+	"yoğ",
+	"yoğu",
+	"y\xc3",
+	"yü",
+	"yük",
+	"yük_",
+	"z",
+	"z_",
+	"z__",
+	"z___",
+	"z____",
+	"zi",
+	"zil",
+	"zile",
+	"ziler",
+	"zin",
+	"zini",
+	"zini_",
+	"ziy",
+	"ziyl",
+	"ziyle",
+	"z\xc4",
+	"zı",
+	"zıl",
+	"zıla",
+	"zın",
+	"zın\xc4",
+	"\x9f",
+	"\x9fi",
+	"\x9fin",
+	"\x9find",
+	"\x9finde",
+	"\x9fr",
+	"\x9fru",
+	"\x9fru_",
+	"\x9fru__",
+	"\x9ft",
+	"\x9ftu",
+	"\x9ftur",
+	"\x9ftura",
+	"\x9ft\xc4",
+	"\x9ftı",
+	"\x9ftır",
+	"\x9fu",
+	"\x9fun",
+	"\x9fun_",
+	"\x9fun__",
+	"\x9f\xc4",
+	"\x9fı",
+	"\x9fıl",
+	"\x9fıla",
+	"\xa7",
+	"\xa7a",
+	"\xa7as",
+	"\xa7as\xc4",
+	"\xa7ası",
+	"\xa7e",
+	"\xa7er",
+	"\xa7erl",
+	"\xa7erli",
+	"\xa7i",
+	"\xa7in",
+	"\xa7in_",
+	"\xa7in__",
+	"\xa7o",
+	"\xa7ok",
+	"\xa7ok_",
+	"\xa7ok__",
+	"\xa7\xc3",
+	"\xa7ü",
+	"\xa7ük",
+	"\xa7ük_",
+	"\xb0",
+	"\xb0y",
+	"\xb0yi",
+	"\xb0yi_",
+	"\xb0yi__",
+	"\xb1",
+	"\xb1k",
+	"\xb1k_",
+	"\xb1k__",
+	"\xb1k___",
+	"\xb1kl",
+	"\xb1kla",
+	"\xb1kla_",
+	"\xb1kl\xc4",
+	"\xb1klı",
+	"\xb1l",
+	"\xb1la",
+	"\xb1lan",
+	"\xb1lan_",
+	"\xb1lana",
+	"\xb1land",
+	"\xb1la\xc5",
+	"\xb1laş",
+	"\xb1m",
+	"\xb1ma",
+	"\xb1ma_",
+	"\xb1ma__",
+	"\xb1n",
+	"\xb1n\xc4",
+	"\xb1nı",
+	"\xb1nı_",
+	"\xb1nın",
+	"\xb1r",
+	"\xb1r_",
+	"\xb1r__",
+	"\xb1r___",
+	"\xb1ra",
+	"\xb1rab",
+	"\xb1rabi",
+	"\xb1ral",
+	"\xb1rala",
+	"\xb1s",
+	"\xb1sa",
+	"\xb1sa_",
+	"\xb1sa__",
+	"\xb6",
+	"\xb6r",
+	"\xb6rn",
+	"\xb6rne",
+	"\xb6rnek",
+	"\xb6rne\xc4",
+	"\xb6r\xc3",
+	"\xb6rü",
+	"\xb6rün",
+	"\xbc",
+	"\xbcl",
+	"\xbcle",
+	"\xbcler",
+	"\xbcleri",
+	"\xbcm",
+	"\xbcml",
+	"\xbcmle",
+	"\xbcmle_",
+	"\xbcn",
+	"\xbcnl",
+	"\xbcnl\xc3",
+	"\xbcnlü",
+	"\xbcnt",
+	"\xbcnt\xc3",
+	"\xbcntü",
+	"\xbcv",
+	"\xbcve",
+	"\xbcven",
+	"\xbcvenl",
+	"\xbcy",
+	"\xbcy\xc3",
+	"\xbcyü",
+	"\xbcyük",
+	"\xbc\xc3",
+	"\xbcç",
+	"\xbcç\xc3",
+	"\xbcçü",
+	"\xc3",
+	"ç",
+	"ça",
+	"ças",
+	"ças\xc4",
+	"çe",
+	"çer",
+	"çerl",
+	"çi",
+	"çin",
+	"çin_",
+	"ço",
+	"çok",
+	"çok_",
+	"ç\xc3",
+	"çü",
+	"çük",
+	"ö",
+	"ör",
+	"örn",
+	"örne",
+	"ör\xc3",
+	"örü",
+	"ü",
+	"ük",
+	"ük_",
+	"ük__",
+	"ül",
+	"üle",
+	"üler",
+	"üm",
+	"üml",
+	"ümle",
+	"ün",
+	"ünl",
+	"ünl\xc3",
+	"ünt",
+	"ünt\xc3",
+	"üv",
+	"üve",
+	"üven",
+	"üy",
+	"üy\xc3",
+	"üyü",
+	"ü\xc3",
+	"üç",
+	"üç\xc3",
+	"\xc4",
+	"ğ",
+	"ği",
+	"ğin",
+	"ğind",
+	"ğr",
+	"ğru",
+	"ğru_",
+	"ğu",
+	"ğun",
+	"ğun_",
+	"İ",
+	"İy",
+	"İyi",
+	"İyi_",
+	"ı",
+	"ı_",
+	"ı__",
+	"ı___",
+	"ık",
+	"ık_",
+	"ık__",
+	"ıkl",
+	"ıkla",
+	"ıkl\xc4",
+	"ıl",
+	"ıla",
+	"ılan",
+	"ıla\xc5",
+	"ım",
+	"ıma",
+	"ıma_",
+	"ın",
+	"ın_",
+	"ın__",
+	"ın\xc4",
+	"ını",
+	"ır",
+	"ır_",
+	"ır__",
+	"ıra",
+	"ırab",
+	"ıral",
+	"ır\xc4",
+	"ırı",
+	"ıs",
+	"ısa",
+	"ısa_",
+	"\xc5",
+	"ş",
+	"şt",
+	"ştu",
+	"ştur",
+	"şt\xc4",
+	"ştı",
+	"ş\xc4",
+	"şı",
+	"şıl",
+}
+
+var turkishRanks = []uint16{
+	1605,
+	1604,
+	1603,
+	1602,
+	1601,
+	1600,
+	1599,
+	1598,
+	1597,
+	1596,
+	1595,
+	1594,
+	1593,
+	1592,
+	1591,
+	1590,
+	1589,
+	1588,
+	1587,
+	1586,
+	1585,
+	1584,
+	1583,
+	1582,
+	1581,
+	1580,
+	1579,
+	1578,
+	1577,
+	1576,
+	1575,
+	1574,
+	1573,
+	1572,
+	1571,
+	1570,
+	1569,
+	1568,
+	1567,
+	1566,
+	1565,
+	1564,
+	1563,
+	1562,
+	1561,
+	301,
+	16,
+	1560,
+	58,
+	1559,
+	1558,
+	132,
+	620,
+	131,
+	48,
+	300,
+	619,
+	1557,
+	176,
+	73,
+	175,
+	130,
+	1556,
+	90,
+	174,
+	1555,
+	299,
+	1554,
+	1553,
+	1552,
+	15,
+	31,
+	618,
+	1551,
+	1550,
+	1549,
+	57,
+	298,
+	1548,
+	129,
+	1547,
+	1546,
+	1545,
+	1544,
+	1543,
+	128,
+	1542,
+	617,
+	616,
+	615,
+	614,
+	127,
+	1541,
+	613,
+	612,
+	47,
+	297,
+	1540,
+	296,
+	1539,
+	295,
+	294,
+	1538,
+	611,
+	610,
+	609,
+	1537,
+	1536,
+	173,
+	293,
+	1535,
+	72,
+	1534,
+	608,
+	1533,
+	292,
+	172,
+	607,
+	606,
+	126,
+	125,
+	1532,
+	1531,
+	89,
+	291,
+	605,
+	1530,
+	171,
+	1529,
+	290,
+	1528,
+	1527,
+	289,
+	1526,
+	1525,
+	1524,
+	1523,
+	1522,
+	1521,
+	14,
+	30,
+	604,
+	37,
+	603,
+	602,
+	1520,
+	1519,
+	1518,
+	1517,
+	1516,
+	56,
+	288,
+	1515,
+	1514,
+	1513,
+	1512,
+	1511,
+	124,
+	287,
+	601,
+	1510,
+	1509,
+	1508,
+	1507,
+	1506,
+	1505,
+	1504,
+	1503,
+	123,
+	1502,
+	1501,
+	600,
+	599,
+	598,
+	597,
+	596,
+	595,
+	594,
+	122,
+	1500,
+	1499,
+	593,
+	592,
+	591,
+	590,
+	46,
+	286,
+	285,
+	1498,
+	1497,
+	284,
+	589,
+	1496,
+	1495,
+	1494,
+	283,
+	282,
+	281,
+	1493,
+	1492,
+	588,
+	587,
+	586,
+	585,
+	584,
+	1491,
+	1490,
+	1489,
+	170,
+	280,
+	279,
+	1488,
+	1487,
+	71,
+	1486,
+	1485,
+	583,
+	582,
+	1484,
+	1483,
+	278,
+	277,
+	169,
+	581,
+	580,
+	579,
+	578,
+	121,
+	120,
+	168,
+	1482,
+	1481,
+	1480,
+	1479,
+	88,
+	276,
+	1478,
+	577,
+	576,
+	1477,
+	1476,
+	1475,
+	1474,
+	167,
+	1473,
+	1472,
+	275,
+	274,
+	1471,
+	1470,
+	1469,
+	273,
+	1468,
+	1467,
+	1466,
+	1465,
+	1464,
+	1463,
+	1462,
+	1461,
+	1460,
+	13,
+	29,
+	575,
+	1459,
+	1458,
+	36,
+	42,
+	1457,
+	574,
+	573,
+	572,
+	1456,
+	1455,
+	1454,
+	1453,
+	1452,
+	1451,
+	1450,
+	55,
+	272,
+	1449,
+	1448,
+	1447,
+	1446,
+	1445,
+	1444,
+	1443,
+	1442,
+	1441,
+	119,
+	271,
+	571,
+	1440,
+	570,
+	569,
+	1439,
+	1438,
+	1437,
+	1436,
+	1435,
+	1434,
+	1433,
+	1432,
+	1431,
+	1430,
+	1429,
+	118,
+	1428,
+	1427,
+	1426,
+	568,
+	567,
+	566,
+	565,
+	564,
+	1425,
+	1424,
+	563,
+	562,
+	561,
+	560,
+	117,
+	1423,
+	1422,
+	1421,
+	559,
+	558,
+	1420,
+	1419,
+	557,
+	556,
+	555,
+	45,
+	270,
+	269,
+	1418,
+	554,
+	1417,
+	1416,
+	1415,
+	268,
+	553,
+	552,
+	1414,
+	1413,
+	1412,
+	1411,
+	1410,
+	267,
+	266,
+	265,
+	264,
+	1409,
+	1408,
+	1407,
+	551,
+	550,
+	1406,
+	1405,
+	549,
+	548,
+	547,
+	546,
+	1404,
+	1403,
+	1402,
+	1401,
+	166,
+	263,
+	262,
+	545,
+	1400,
+	1399,
+	1398,
+	1397,
+	70,
+	1396,
+	1395,
+	1394,
+	544,
+	543,
+	542,
+	1393,
+	1392,
+	1391,
+	261,
+	260,
+	541,
+	1390,
+	165,
+	540,
+	539,
+	538,
+	537,
+	536,
+	1389,
+	1388,
+	116,
+	115,
+	164,
+	163,
+	1387,
+	1386,
+	1385,
+	1384,
+	1383,
+	1382,
+	87,
+	259,
+	1381,
+	1380,
+	535,
+	534,
+	533,
+	1379,
+	1378,
+	1377,
+	1376,
+	1375,
+	1374,
+	1373,
+	162,
+	1372,
+	1371,
+	1370,
+	258,
+	257,
+	532,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	2,
+	86,
+	85,
+	84,
+	83,
+	531,
+	530,
+	529,
+	528,
+	1364,
+	1363,
+	1362,
+	1361,
+	1360,
+	1359,
+	1358,
+	1357,
+	161,
+	256,
+	255,
+	254,
+	1356,
+	1355,
+	1354,
+	1353,
+	1352,
+	1351,
+	1350,
+	253,
+	1349,
+	1348,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	1342,
+	1341,
+	61,
+	527,
+	526,
+	525,
+	1340,
+	1339,
+	1338,
+	1337,
+	1336,
+	1335,
+	114,
+	113,
+	524,
+	523,
+	1334,
+	1333,
+	1332,
+	1331,
+	1330,
+	35,
+	1329,
+	1328,
+	1327,
+	252,
+	1326,
+	1325,
+	1324,
+	1323,
+	1322,
+	1321,
+	522,
+	521,
+	520,
+	1320,
+	1319,
+	1318,
+	519,
+	518,
+	517,
+	1317,
+	1316,
+	1315,
+	1314,
+	1313,
+	1312,
+	516,
+	515,
+	514,
+	513,
+	512,
+	511,
+	1311,
+	1310,
+	251,
+	510,
+	1309,
+	1308,
+	1307,
+	1306,
+	1305,
+	1304,
+	1303,
+	250,
+	1302,
+	1301,
+	1300,
+	509,
+	508,
+	1299,
+	1298,
+	507,
+	506,
+	505,
+	504,
+	9,
+	1297,
+	1296,
+	1295,
+	1294,
+	21,
+	160,
+	1293,
+	1292,
+	249,
+	1291,
+	503,
+	34,
+	41,
+	40,
+	1290,
+	1289,
+	502,
+	501,
+	500,
+	499,
+	1288,
+	1287,
+	1286,
+	1285,
+	248,
+	498,
+	1284,
+	1283,
+	1282,
+	1281,
+	1280,
+	1279,
+	1278,
+	1277,
+	1276,
+	1275,
+	28,
+	159,
+	1274,
+	1273,
+	1272,
+	1271,
+	1270,
+	1269,
+	1268,
+	1267,
+	1266,
+	1265,
+	1264,
+	1263,
+	247,
+	497,
+	496,
+	495,
+	1262,
+	1261,
+	1260,
+	82,
+	246,
+	494,
+	493,
+	1259,
+	1258,
+	1257,
+	1256,
+	1255,
+	492,
+	491,
+	490,
+	1254,
+	1253,
+	1252,
+	1251,
+	1250,
+	1249,
+	1248,
+	1247,
+	4,
+	54,
+	53,
+	52,
+	51,
+	1246,
+	1245,
+	1244,
+	1243,
+	1242,
+	1241,
+	1240,
+	1239,
+	245,
+	1238,
+	1237,
+	1236,
+	1235,
+	1234,
+	1233,
+	1232,
+	1231,
+	1230,
+	1229,
+	1228,
+	1227,
+	1226,
+	489,
+	1225,
+	1224,
+	1223,
+	1222,
+	1221,
+	1220,
+	158,
+	488,
+	487,
+	486,
+	1219,
+	1218,
+	1217,
+	1216,
+	1215,
+	1214,
+	50,
+	244,
+	243,
+	242,
+	112,
+	1213,
+	1212,
+	157,
+	1211,
+	1210,
+	1209,
+	1208,
+	485,
+	1207,
+	1206,
+	1205,
+	1204,
+	241,
+	1203,
+	1202,
+	1201,
+	1200,
+	1199,
+	1198,
+	1197,
+	1196,
+	1195,
+	1194,
+	1193,
+	1192,
+	1191,
+	1190,
+	1189,
+	1188,
+	1187,
+	111,
+	484,
+	483,
+	482,
+	481,
+	1186,
+	1185,
+	1184,
+	1183,
+	480,
+	1182,
+	1181,
+	1180,
+	1179,
+	1178,
+	1177,
+	110,
+	1176,
+	1175,
+	1174,
+	1173,
+	479,
+	478,
+	477,
+	1172,
+	1171,
+	476,
+	475,
+	1170,
+	1169,
+	1168,
+	1167,
+	240,
+	239,
+	1166,
+	1165,
+	1164,
+	474,
+	473,
+	472,
+	1,
+	156,
+	155,
+	154,
+	153,
+	1163,
+	1162,
+	1161,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	44,
+	238,
+	237,
+	236,
+	152,
+	1155,
+	1154,
+	1153,
+	1152,
+	471,
+	1151,
+	1150,
+	235,
+	470,
+	1149,
+	1148,
+	1147,
+	1146,
+	1145,
+	1144,
+	1143,
+	43,
+	151,
+	150,
+	149,
+	469,
+	468,
+	1142,
+	1141,
+	467,
+	466,
+	465,
+	464,
+	1140,
+	1139,
+	1138,
+	1137,
+	1136,
+	1135,
+	1134,
+	22,
+	27,
+	26,
+	25,
+	1133,
+	1132,
+	1131,
+	463,
+	462,
+	461,
+	460,
+	1130,
+	1129,
+	1128,
+	1127,
+	1126,
+	1125,
+	1124,
+	1123,
+	148,
+	147,
+	459,
+	458,
+	1122,
+	1121,
+	1120,
+	1119,
+	457,
+	456,
+	455,
+	454,
+	10,
+	234,
+	233,
+	232,
+	231,
+	230,
+	229,
+	1118,
+	1117,
+	453,
+	452,
+	451,
+	1116,
+	1115,
+	1114,
+	1113,
+	1112,
+	1111,
+	228,
+	1110,
+	1109,
+	1108,
+	1107,
+	1106,
+	1105,
+	1104,
+	1103,
+	1102,
+	1101,
+	1100,
+	1099,
+	1098,
+	146,
+	227,
+	1097,
+	1096,
+	450,
+	449,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	226,
+	225,
+	224,
+	223,
+	5,
+	145,
+	144,
+	143,
+	142,
+	60,
+	109,
+	1088,
+	1087,
+	1086,
+	1085,
+	1084,
+	1083,
+	448,
+	447,
+	1082,
+	1081,
+	1080,
+	1079,
+	1078,
+	1077,
+	446,
+	445,
+	444,
+	39,
+	108,
+	107,
+	106,
+	1076,
+	1075,
+	1074,
+	81,
+	443,
+	442,
+	222,
+	221,
+	1073,
+	1072,
+	141,
+	1071,
+	1070,
+	1069,
+	441,
+	1068,
+	1067,
+	1066,
+	1065,
+	1064,
+	1063,
+	1062,
+	220,
+	440,
+	439,
+	438,
+	1061,
+	1060,
+	1059,
+	1058,
+	1057,
+	1056,
+	1055,
+	1054,
+	1053,
+	1052,
+	1051,
+	437,
+	436,
+	1050,
+	1049,
+	1048,
+	1047,
+	33,
+	435,
+	434,
+	433,
+	432,
+	80,
+	219,
+	218,
+	217,
+	216,
+	431,
+	430,
+	1046,
+	1045,
+	429,
+	428,
+	1044,
+	1043,
+	1042,
+	1041,
+	1040,
+	1039,
+	1038,
+	1037,
+	427,
+	1036,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	6,
+	79,
+	78,
+	77,
+	76,
+	426,
+	425,
+	424,
+	423,
+	422,
+	421,
+	1030,
+	1029,
+	1028,
+	1027,
+	215,
+	1026,
+	1025,
+	1024,
+	420,
+	419,
+	418,
+	140,
+	1023,
+	1022,
+	1021,
+	1020,
+	1019,
+	1018,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	1012,
+	214,
+	213,
+	212,
+	211,
+	417,
+	1011,
+	1010,
+	1009,
+	1008,
+	1007,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	1001,
+	1000,
+	999,
+	998,
+	69,
+	68,
+	997,
+	996,
+	416,
+	415,
+	414,
+	413,
+	995,
+	994,
+	993,
+	992,
+	67,
+	991,
+	990,
+	989,
+	988,
+	412,
+	411,
+	410,
+	409,
+	987,
+	986,
+	985,
+	984,
+	983,
+	982,
+	981,
+	980,
+	408,
+	407,
+	979,
+	978,
+	977,
+	976,
+	105,
+	975,
+	974,
+	973,
+	972,
+	210,
+	209,
+	406,
+	405,
+	971,
+	970,
+	969,
+	968,
+	967,
+	966,
+	3,
+	20,
+	19,
+	18,
+	17,
+	49,
+	965,
+	964,
+	963,
+	404,
+	403,
+	402,
+	962,
+	961,
+	960,
+	959,
+	958,
+	957,
+	208,
+	956,
+	955,
+	954,
+	953,
+	952,
+	951,
+	401,
+	950,
+	949,
+	948,
+	947,
+	400,
+	399,
+	398,
+	397,
+	104,
+	946,
+	945,
+	944,
+	139,
+	943,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	207,
+	935,
+	934,
+	933,
+	932,
+	931,
+	930,
+	929,
+	928,
+	927,
+	396,
+	395,
+	394,
+	393,
+	392,
+	391,
+	926,
+	925,
+	924,
+	923,
+	922,
+	921,
+	920,
+	919,
+	918,
+	917,
+	916,
+	915,
+	206,
+	390,
+	914,
+	913,
+	912,
+	911,
+	910,
+	909,
+	908,
+	907,
+	906,
+	905,
+	904,
+	389,
+	388,
+	387,
+	386,
+	24,
+	138,
+	205,
+	204,
+	203,
+	903,
+	902,
+	901,
+	385,
+	384,
+	383,
+	382,
+	900,
+	899,
+	898,
+	897,
+	381,
+	380,
+	379,
+	896,
+	895,
+	894,
+	893,
+	892,
+	891,
+	103,
+	102,
+	890,
+	889,
+	378,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	23,
+	202,
+	377,
+	376,
+	375,
+	882,
+	881,
+	880,
+	101,
+	374,
+	879,
+	878,
+	877,
+	876,
+	373,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	862,
+	861,
+	372,
+	860,
+	859,
+	858,
+	857,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	371,
+	370,
+	369,
+	850,
+	849,
+	38,
+	201,
+	200,
+	199,
+	198,
+	197,
+	848,
+	847,
+	846,
+	368,
+	367,
+	366,
+	365,
+	845,
+	844,
+	843,
+	842,
+	841,
+	840,
+	839,
+	838,
+	837,
+	836,
+	835,
+	834,
+	833,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	826,
+	825,
+	824,
+	75,
+	74,
+	137,
+	136,
+	135,
+	823,
+	822,
+	821,
+	820,
+	819,
+	818,
+	817,
+	816,
+	815,
+	814,
+	813,
+	32,
+	100,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	364,
+	363,
+	362,
+	361,
+	803,
+	802,
+	801,
+	800,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	786,
+	785,
+	784,
+	783,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	776,
+	775,
+	774,
+	66,
+	773,
+	772,
+	771,
+	770,
+	134,
+	360,
+	359,
+	358,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	357,
+	356,
+	763,
+	762,
+	761,
+	760,
+	62,
+	759,
+	758,
+	757,
+	756,
+	755,
+	754,
+	753,
+	752,
+	196,
+	751,
+	750,
+	749,
+	355,
+	354,
+	353,
+	748,
+	747,
+	746,
+	745,
+	352,
+	351,
+	350,
+	349,
+	65,
+	744,
+	743,
+	742,
+	741,
+	740,
+	739,
+	738,
+	737,
+	348,
+	347,
+	346,
+	345,
+	344,
+	343,
+	342,
+	341,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	728,
+	12,
+	195,
+	727,
+	726,
+	725,
+	340,
+	724,
+	723,
+	722,
+	721,
+	99,
+	98,
+	194,
+	720,
+	719,
+	718,
+	339,
+	338,
+	337,
+	336,
+	335,
+	334,
+	333,
+	332,
+	331,
+	717,
+	716,
+	193,
+	715,
+	714,
+	713,
+	330,
+	712,
+	711,
+	710,
+	709,
+	192,
+	191,
+	190,
+	189,
+	188,
+	187,
+	329,
+	328,
+	708,
+	707,
+	706,
+	705,
+	704,
+	64,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	696,
+	327,
+	695,
+	694,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	686,
+	685,
+	684,
+	683,
+	682,
+	681,
+	680,
+	679,
+	678,
+	11,
+	63,
+	677,
+	676,
+	675,
+	674,
+	673,
+	672,
+	326,
+	325,
+	324,
+	323,
+	322,
+	321,
+	671,
+	670,
+	669,
+	186,
+	185,
+	320,
+	319,
+	668,
+	667,
+	59,
+	318,
+	317,
+	316,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	315,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	654,
+	653,
+	652,
+	651,
+	650,
+	649,
+	648,
+	7,
+	184,
+	647,
+	646,
+	645,
+	644,
+	643,
+	642,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	635,
+	8,
+	97,
+	96,
+	95,
+	183,
+	634,
+	633,
+	314,
+	632,
+	631,
+	94,
+	93,
+	182,
+	313,
+	312,
+	311,
+	310,
+	181,
+	630,
+	629,
+	309,
+	308,
+	133,
+	628,
+	627,
+	307,
+	626,
+	625,
+	624,
+	623,
+	180,
+	179,
+	178,
+	92,
+	91,
+	177,
+	622,
+	621,
+	306,
+	305,
+	304,
+	303,
+	302,
+}
+
+// generatedDefaultLanguages backs defaultLanguages and, through it, DefaultDetector. It is built
+// entirely from Go literals above: no file I/O, no JSON decoding, no init-time logging.
+var generatedDefaultLanguages = []LanguageComparator{
+	&Language{
+		Name:    "arabic",
+		Profile: BuildProfile(arabicTokens, arabicRanks),
+		Tag:     language.MustParse("ar"),
+		Scripts: []Script{ScriptArabic},
+	},
+	&Language{
+		Name:    "english",
+		Profile: BuildProfile(englishTokens, englishRanks),
+		Tag:     language.MustParse("en"),
+		Scripts: []Script{ScriptLatin},
+	},
+	&Language{
+		Name:    "french",
+		Profile: BuildProfile(frenchTokens, frenchRanks),
+		Tag:     language.MustParse("fr"),
+		Scripts: []Script{ScriptLatin},
+	},
+	&Language{
+		Name:    "german",
+		Profile: BuildProfile(germanTokens, germanRanks),
+		Tag:     language.MustParse("de"),
+		Scripts: []Script{ScriptLatin},
+	},
+	&Language{
+		Name:    "hebrew",
+		Profile: BuildProfile(hebrewTokens, hebrewRanks),
+		Tag:     language.MustParse("he"),
+		Scripts: []Script{ScriptHebrew},
+	},
+	&Language{
+		Name:    "russian",
+		Profile: BuildProfile(russianTokens, russianRanks),
+		Tag:     language.MustParse("ru"),
+		Scripts: []Script{ScriptCyrillic},
+	},
+	&Language{
+		Name:    "turkish",
+		Profile: BuildProfile(turkishTokens, turkishRanks),
+		Tag:     language.MustParse("tr"),
+		Scripts: []Script{ScriptLatin},
+	},
+}
@@ -0,0 +1,28 @@
+package langdet_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chrisport/go-lang-detector/langdet"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDefaultDetectorIsPopulatedFromGeneratedTable(t *testing.T) {
+	Convey("Subject: Test DefaultDetector", t, func() {
+		Convey("It should come pre-populated with the bundled default languages", func() {
+			So(len(langdet.DefaultDetector.Languages), ShouldEqual, 7)
+		})
+	})
+}
+
+func TestInitDefaultsFromReaderBackCompat(t *testing.T) {
+	Convey("Subject: Test InitDefaultsFromReader", t, func() {
+		Convey("It should still accept a custom JSON reader and register its languages", func() {
+			before := len(langdet.NewDefaultLanguages().Languages)
+			err := langdet.InitDefaultsFromReader(strings.NewReader(`[{"Name":"esperanto","Profile":{}}]`))
+			So(err, ShouldBeNil)
+			So(len(langdet.NewDefaultLanguages().Languages), ShouldEqual, before+1)
+		})
+	})
+}
@@ -1,15 +1,18 @@
 package langdet
 
+//go:generate go run ../cmd/genprofiles -corpus ../cmd/genprofiles/corpus -out .
+
 import (
+	"bufio"
+	"context"
 	"encoding/json"
-	"github.com/chrisport/go-lang-detector/langdet/internal"
 	"github.com/pkg/errors"
 	"io"
-	"io/ioutil"
 	"log"
 	"sort"
-	"strings"
-	"bytes"
+	"sync"
+
+	"golang.org/x/text/language"
 )
 
 // the depth of n-gram tokens that are created. if nDepth=1, only 1-letter tokens are created
@@ -18,27 +21,11 @@ const nDepth = 4
 // DefaultMinimumConfidence is the minimum confidence that a language-match must have to be returned as detected language
 var DefaultMinimumConfidence float32 = 0.7
 
-var defaultLanguages = []LanguageComparator{}
-
-var DefaultDetector = Detector{defaultLanguages, DefaultMinimumConfidence}
-
-func init() {
-	//TODO remove the file parsing some time in future
-	analyzedInput, err := ioutil.ReadFile("default_languages.json")
-	if err == nil {
-		InitDefaultsFromReader(bytes.NewReader(analyzedInput))
-		log.Println("Usage of default json is deprecated, default libraries are provided automatically without json file.\n" +
-			"To provide custom defaults, please use InitWithDefault")
-		return
-	}
-
-	def, err := internal.Asset("default_languages.json")
-	if err != nil {
-		log.Println("Could not initialize default languages")
-	}
+// defaultLanguages holds the bundled default languages, built from the Go literals in
+// default_profiles.go (see cmd/genprofiles) rather than decoded from JSON at startup.
+var defaultLanguages = append([]LanguageComparator{}, generatedDefaultLanguages...)
 
-	InitDefaultsFromReader(strings.NewReader(string(def)))
-}
+var DefaultDetector = Detector{Languages: defaultLanguages, MinimumConfidence: DefaultMinimumConfidence, mu: &sync.RWMutex{}}
 
 // InitDefaultsFromReader initializes the default languages with a provided Reader
 // containing a Marshaled array of Languages
@@ -49,74 +36,134 @@ func InitDefaultsFromReader(reader io.Reader) error {
 		return errors.Wrap(err, "Could not process languages from io.Reader.")
 	}
 	for i := range lan {
+		if lan[i].Tag == language.Und {
+			if tag, ok := defaultTags[lan[i].Name]; ok {
+				lan[i].Tag = tag
+			}
+		}
+		if len(lan[i].Scripts) == 0 {
+			if scripts, ok := defaultScripts[lan[i].Name]; ok {
+				lan[i].Scripts = scripts
+			}
+		}
 		defaultLanguages = append(defaultLanguages, &lan[i])
 	}
 	return nil
 }
 
 // Detector has an array of detectable Languages and methods to determine the closest Language to a text.
+//
+// The zero value is ready to use, matching prior versions of this package, but is only safe for
+// single-goroutine use. A Detector obtained from NewDetector, NewDefaultLanguages or Snapshot is
+// safe for concurrent AddLanguage*/GetClosestLanguage/GetLanguages/DetectReader calls from
+// multiple goroutines: mu guards Languages, and AddLanguage* always replaces it with a new slice
+// rather than mutating it in place, so a slice obtained by a reader stays valid no matter what a
+// concurrent writer does afterwards. mu is a pointer so that copying a Detector by value - which
+// NewDetector, NewDefaultLanguages and Snapshot all do - shares the lock instead of handing out an
+// independent one, and so that Detector itself stays copyable (go vet's copylocks check would flag
+// a sync.RWMutex held by value).
 type Detector struct {
 	Languages         []LanguageComparator
 	MinimumConfidence float32
+	// Scorer, if set, is used instead of each LanguageComparator's own CompareTo to rate sample
+	// similarity, via LanguageComparator.GetProfile. Setting it also switches GetClosestLanguage,
+	// GetLanguages, DetectReader and DetectReaderContext to calibrated confidence: each
+	// DetectionResult.Confidence becomes the percentage gap between its Score and the next-best
+	// candidate's Score - how much better a match it is than the runner-up - rather than a
+	// percentage computed from that one candidate in isolation. Leave it nil to keep prior
+	// versions' CompareTo-based behavior unchanged.
+	Scorer Scorer
+
+	mu *sync.RWMutex
+}
+
+// lock returns d's mutex, initializing it on first use. This makes the zero-value Detector usable
+// without a constructor, matching prior versions of this package; it is not itself safe to call
+// concurrently, so concurrent use requires going through NewDetector, NewDefaultLanguages or
+// Snapshot, which all pre-initialize mu.
+func (d *Detector) lock() *sync.RWMutex {
+	if d.mu == nil {
+		d.mu = &sync.RWMutex{}
+	}
+	return d.mu
+}
+
+// languagesSnapshot returns d.Languages as of now, taken under a read lock. Because AddLanguage*
+// never mutates the slice in place, the returned slice can be read freely afterwards without
+// holding any lock, even while another goroutine concurrently registers further languages.
+func (d *Detector) languagesSnapshot() []LanguageComparator {
+	mu := d.lock()
+	mu.RLock()
+	defer mu.RUnlock()
+	return d.Languages
 }
 
 // NewDetector returns a new Detector without any language.
 // It can be used to add languages selectively.
 func NewDetector() Detector {
-	return Detector{[]LanguageComparator{}, DefaultMinimumConfidence}
+	return Detector{Languages: []LanguageComparator{}, MinimumConfidence: DefaultMinimumConfidence, mu: &sync.RWMutex{}}
 }
 
 // NewDefaultLanguages returns a new Detector with the default languages, if loaded:
 // currently: Arabic, English, French, German, Hebrew, Russian, Turkish
 func NewDefaultLanguages() Detector {
-	return Detector{defaultLanguages, DefaultMinimumConfidence}
+	return Detector{Languages: defaultLanguages, MinimumConfidence: DefaultMinimumConfidence, mu: &sync.RWMutex{}}
+}
+
+// Snapshot returns a Detector that shares this Detector's Languages and MinimumConfidence as of
+// now, but has its own lock. It is a cheap, independent read-only view: callers can keep detecting
+// against it concurrently with this Detector registering further languages, and will never observe
+// a partially-updated Languages slice.
+func (d *Detector) Snapshot() Detector {
+	return Detector{
+		Languages:         d.languagesSnapshot(),
+		MinimumConfidence: d.MinimumConfidence,
+		mu:                &sync.RWMutex{},
+	}
 }
 
 // Add language analyzes a text and creates a new Language with given name.
 // The new language will be detectable afterwards by this Detector instance.
 func (d *Detector) AddLanguageFromText(textToAnalyze, languageName string) {
-	if len(d.Languages) == 0 {
-		d.Languages = make([]LanguageComparator, 0, 0)
-	}
 	analyzedLanguage := Analyze(textToAnalyze, languageName)
-	updatedList := append(d.Languages, &analyzedLanguage)
-	d.Languages = updatedList
+	d.AddLanguageComparators(&analyzedLanguage)
 }
 
 // Add language adds a languageComparator to the list of detectable languages by this Detector instance.
 func (d *Detector) AddLanguageComparators(comparators ...LanguageComparator) {
-	if d.Languages == nil {
-		d.Languages = make([]LanguageComparator, 0, 0)
-	}
-	for i := range comparators {
-		d.Languages = append(d.Languages, comparators[i])
-	}
+	mu := d.lock()
+	mu.Lock()
+	defer mu.Unlock()
+	updated := make([]LanguageComparator, len(d.Languages), len(d.Languages)+len(comparators))
+	copy(updated, d.Languages)
+	d.Languages = append(updated, comparators...)
 }
 
 // Add language adds a language to the list of detectable languages by this Detector instance.
 func (d *Detector) AddLanguage(languages ...Language) {
-	if d.Languages == nil {
-		d.Languages = make([]LanguageComparator, 0, 0)
-	}
+	comparators := make([]LanguageComparator, len(languages))
 	for i := range languages {
-		d.Languages = append(d.Languages, &languages[i])
+		comparators[i] = &languages[i]
 	}
+	d.AddLanguageComparators(comparators...)
 }
 
 // GetClosestLanguage returns the name of the language which is closest to the given text if it is confident enough.
 // It returns undefined otherwise. Set detector's MinimumConfidence for customization.
 func (d *Detector) GetClosestLanguage(text string) string {
-	if d.MinimumConfidence <= 0 || d.MinimumConfidence > 1 {
-		d.MinimumConfidence = DefaultMinimumConfidence
+	minConfidence := d.MinimumConfidence
+	if minConfidence <= 0 || minConfidence > 1 {
+		minConfidence = DefaultMinimumConfidence
 	}
-	if len(d.Languages) == 0 {
+	languages := d.languagesSnapshot()
+	if len(languages) == 0 {
 		log.Println("no languages configured for this detector")
 		return "undefined"
 	}
 	lmap := lazyLookupMap(text, nDepth)
-	c := d.closestFromTable(lmap, text)
+	c := closestFromTable(languages, lmap, text, d.Scorer)
 
-	if len(c) == 0 || c[0].Confidence < asPercent(d.MinimumConfidence) {
+	if len(c) == 0 || c[0].Confidence < asPercent(minConfidence) {
 		return "undefined"
 	}
 	return c[0].Name
@@ -135,47 +182,199 @@ var lazyLookupMap = func(text string, nDepth int) func() map[string]int {
 
 // GetLanguages analyzes a text and returns the DetectionResult of all languages of this detector.
 func (d *Detector) GetLanguages(text string) []DetectionResult {
+	languages := d.languagesSnapshot()
 	lazyLookupMap := lazyLookupMap(text, nDepth)
-	results := d.closestFromTable(lazyLookupMap, text)
-	return results
+	return closestFromTable(languages, lazyLookupMap, text, d.Scorer)
 }
 
-// closestFromTable compares a lookupMap map[token]rank with all languages of this Detector and returns
-// an array containing all DetectionResults
-func (d *Detector) closestFromTable(lookupMap func() map[string]int, originalInput string) []DetectionResult {
-	res := []DetectionResult{}
+// streamWindow is the number of runes DetectReader/DetectReaderContext consume between two
+// rescorings of the accumulated occurrence map.
+const streamWindow = 200
+
+// streamMarginTolerance is how much the leading candidate's margin over the runner-up may drift
+// between two consecutive windows and still be considered stable.
+const streamMarginTolerance = 5
+
+// DetectReader incrementally detects the language of r, the same way GetClosestLanguage does for
+// a complete string, but without ever holding the whole input in memory: it consumes r rune by
+// rune via bufio.Reader.ReadRune and re-scores every streamWindow runes. Once the leading
+// candidate clears MinimumConfidence and its margin over the runner-up stays within
+// streamMarginTolerance across two consecutive windows, it returns early without reading the rest
+// of r. It returns "undefined" if no candidate is confident enough by the time r is exhausted.
+func (d *Detector) DetectReader(r io.Reader) (string, error) {
+	return d.DetectReaderContext(context.Background(), r)
+}
 
-	for _, language := range d.Languages {
+// DetectReaderContext is DetectReader with a context.Context that aborts the read loop early, for
+// callers consuming network streams or other readers that might never yield io.EOF on their own.
+func (d *Detector) DetectReaderContext(ctx context.Context, r io.Reader) (string, error) {
+	minConfidence := d.MinimumConfidence
+	if minConfidence <= 0 || minConfidence > 1 {
+		minConfidence = DefaultMinimumConfidence
+	}
+	languages := d.languagesSnapshot()
+	if len(languages) == 0 {
+		log.Println("no languages configured for this detector")
+		return "undefined", nil
+	}
+
+	acc := NewOccurrenceAccumulator(nDepth)
+	br := bufio.NewReader(r)
+
+	var prevName string
+	var prevMargin int
+	havePrev := false
+	runesSinceScore := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "undefined", ctx.Err()
+		default:
+		}
+
+		rn, _, err := br.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "undefined", errors.Wrap(err, "could not read from reader")
+		}
+		acc.Update(rn)
+		runesSinceScore++
+
+		if runesSinceScore < streamWindow {
+			continue
+		}
+		runesSinceScore = 0
+
+		name, margin, confident := scoreAccumulator(languages, acc, minConfidence, d.Scorer)
+		if !confident {
+			havePrev = false
+			continue
+		}
+		if havePrev && name == prevName && abs(margin-prevMargin) <= streamMarginTolerance {
+			return name, nil
+		}
+		prevName, prevMargin, havePrev = name, margin, true
+	}
+
+	name, _, confident := scoreAccumulator(languages, acc, minConfidence, d.Scorer)
+	if !confident {
+		return "undefined", nil
+	}
+	return name, nil
+}
+
+// scoreAccumulator scores the occurrence map and script distribution collected so far by acc
+// against languages and reports the leading candidate's name and its margin (confidence points)
+// over the runner-up. The third return value is false if there is no candidate or the leader
+// doesn't clear minConfidence.
+func scoreAccumulator(languages []LanguageComparator, acc *OccurrenceAccumulator, minConfidence float32, scorer Scorer) (name string, margin int, confident bool) {
+	rankMap := CreateRankLookupMap(acc.Occurrences())
+	lazyLookupMap := func() map[string]int { return rankMap }
+	dominant, total := acc.Scripts()
+
+	results := closestFromScripts(languages, lazyLookupMap, "", dominant, total, scorer)
+	if len(results) == 0 || results[0].Confidence < asPercent(minConfidence) {
+		return "", 0, false
+	}
+
+	margin = results[0].Confidence
+	if len(results) > 1 {
+		margin = results[0].Confidence - results[1].Confidence
+	}
+	return results[0].Name, margin, true
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// closestFromTable compares a lookupMap map[token]rank with the given languages and returns an
+// array containing all DetectionResults.
+//
+// Before running the (expensive) n-gram comparison, it computes the dominant Unicode script of
+// originalInput. If that script uniquely identifies a registered language (e.g. Hangul -> Korean),
+// it short-circuits to a single, fully confident DetectionResult. Otherwise, any LanguageComparator
+// whose configured Scripts don't include the dominant script is skipped, since its profile cannot
+// possibly match.
+func closestFromTable(languages []LanguageComparator, lookupMap func() map[string]int, originalInput string, scorer Scorer) []DetectionResult {
+	dominant, total := dominantScript(scriptCounts(originalInput))
+	return closestFromScripts(languages, lookupMap, originalInput, dominant, total, scorer)
+}
+
+// closestFromScripts is closestFromTable's script-prefilter logic, split out so callers that
+// already track script counts incrementally (DetectReader, DetectReaderContext) don't have to
+// rescan the whole text to get them. originalInput is passed through to LanguageComparator.CompareTo
+// only; callers that don't have a materialized text (streaming) can pass "".
+//
+// If scorer is nil, each language's own CompareTo computes its DetectionResult, exactly as in
+// prior versions of this package. If scorer is set, it is applied to every language's GetProfile
+// instead, and the results are calibrated (see calibrateConfidence) rather than each carrying a
+// Confidence computed in isolation.
+func closestFromScripts(languages []LanguageComparator, lookupMap func() map[string]int, originalInput string, dominant Script, total int, scorer Scorer) []DetectionResult {
+	if total > 0 {
+		if name, ok := exclusiveScripts[dominant]; ok {
+			for _, language := range languages {
+				if language.GetName() == name {
+					return []DetectionResult{{Name: name, Confidence: 100, Score: 1}}
+				}
+			}
+		}
+	}
+
+	res := []DetectionResult{}
+	for _, language := range languages {
+		if total > 0 && !compatibleWithScript(language.GetScripts(), dominant) {
+			continue
+		}
+		if scorer != nil {
+			res = append(res, DetectionResult{Name: language.GetName(), Score: scorer.Score(language.GetProfile(), lookupMap())})
+			continue
+		}
 		res = append(res, language.CompareTo(lookupMap, originalInput))
 	}
 
-	sort.Sort(ResByConf(res))
+	if scorer != nil {
+		sort.Slice(res, func(i, j int) bool { return res[i].Score > res[j].Score })
+		calibrateConfidence(res)
+	} else {
+		sort.Sort(ResByConf(res))
+	}
 	return res
 }
 
-// CompareTo calculates the out-of-place distance between two Profiles,
-// taking into account only items of mapA, that have a value bigger then 300
-func GetDistance(mapA, mapB map[string]int, maxDist int) int {
-	var result int
-	negMaxDist := (-1) * maxDist
-	for key, rankA := range mapA {
-		if rankA > 300 {
+// calibrateConfidence fills in the Confidence of each already Score-sorted-descending result as
+// the percentage gap between its Score and the next-best candidate's Score - how much better a
+// match it is than the runner-up - which is what makes Detector.MinimumConfidence a meaningful
+// "how much better must the winner be" threshold instead of a raw percentage of one candidate's
+// distance. The last (worst) candidate has no runner-up to compare against, so its Confidence
+// falls back to its own Score as a percentage.
+func calibrateConfidence(res []DetectionResult) {
+	for i := range res {
+		if i == len(res)-1 {
+			res[i].Confidence = int(res[i].Score * 100)
 			continue
 		}
-		var diff int
-		if rankB, ok := mapB[key]; ok {
-			diff = rankB - rankA
-			if diff > maxDist || diff < negMaxDist {
-				diff = maxDist
-			} else if diff < 0 {
-				diff = diff * (-1)
-			}
-		} else {
-			diff = maxDist
+		if res[i].Score == 0 {
+			res[i].Confidence = 0
+			continue
 		}
-		result += diff
+		gap := (res[i].Score - res[i+1].Score) / res[i].Score
+		res[i].Confidence = int(gap * 100)
 	}
-	return result
+}
+
+// GetDistance calculates the out-of-place distance between two Profiles, taking into account only
+// items of mapA that have a rank of 300 or lower. It is the distance function behind
+// CavnarTrenkleScorer with its zero-value RankCutoff; see getDistanceWithCutoff to parameterize
+// the cutoff.
+func GetDistance(mapA, mapB map[string]int, maxDist int) int {
+	return getDistanceWithCutoff(mapA, mapB, maxDist, 300)
 }
 
 // asPercentage takes a float and returns its value in percent, rounded to 1%
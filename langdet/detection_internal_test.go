@@ -0,0 +1,39 @@
+package langdet
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+type mockComparator struct {
+}
+
+func (f *mockComparator) CompareTo(lazyLookupMap func() map[string]int, originalText string) DetectionResult {
+	return DetectionResult{Name: "fake", Confidence: 99}
+}
+
+func (f *mockComparator) GetName() string { return "fake" }
+
+func (f *mockComparator) GetTag() language.Tag { return language.Und }
+
+func (f *mockComparator) GetScripts() []Script { return nil }
+
+func (f *mockComparator) GetProfile() map[string]int { return nil }
+
+func TestLanguageComparator(t *testing.T) {
+	Convey("given no language needs lookup map ", t, func() {
+		d := NewDetector()
+		mocklLM := func(text string, nDepth int) func() map[string]int {
+			return func() map[string]int { panic("shouldn't be needed") }
+		}
+		lazyLookupMap, mocklLM = mocklLM, lazyLookupMap
+		d.AddLanguageComparators(&mockComparator{})
+		res := d.GetClosestLanguage("some dummy text")
+		Convey("Never call lazyLookupMap and return result", func() {
+			So(d.Languages, ShouldNotBeNil)
+			So(res, ShouldEqual, "fake")
+		})
+		lazyLookupMap, mocklLM = mocklLM, lazyLookupMap
+	})
+}
@@ -0,0 +1,87 @@
+package langdet
+
+import "golang.org/x/text/language"
+
+// Token represents a text token and its occurence in an analyzed text
+type Token struct {
+	Occurrence int
+	Key        string
+}
+
+// ByOccurrence represents an array of tokens which can be sorted by occurrences of the tokens.
+type ByOccurrence []Token
+
+func (a ByOccurrence) Len() int      { return len(a) }
+func (a ByOccurrence) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a ByOccurrence) Less(i, j int) bool {
+	if a[i].Occurrence == a[j].Occurrence {
+		return a[i].Key < a[j].Key
+	}
+	return a[i].Occurrence < a[j].Occurrence
+}
+
+// LanguageComparator represents anything that can rate how close an already tokenized text is to a language.
+type LanguageComparator interface {
+	CompareTo(lazyLookupMap func() map[string]int, originalText string) DetectionResult
+	GetName() string
+	// GetTag returns the BCP 47 tag configured for this language, or language.Und if none was set.
+	GetTag() language.Tag
+	// GetScripts returns the Unicode scripts this language is expected to be written in, used to
+	// prefilter candidates before n-gram comparison. A nil/empty result means no restriction.
+	GetScripts() []Script
+	// GetProfile returns the rank map backing this comparator, so a Detector-configured Scorer can
+	// be applied to it directly instead of going through CompareTo's built-in metric.
+	GetProfile() map[string]int
+}
+
+// Language represents a language by its name and the profile ( map[token]OccurrenceRank ).
+// Tag is the BCP 47 tag (e.g. "en", "pt-BR") this Language corresponds to, used by
+// Detector.MatchTag and Detector.ParseAcceptLanguage to resolve a UI locale. It is optional;
+// the zero value language.Und means no tag was configured.
+// Scripts restricts which Unicode scripts this language's text is expected to use; it lets
+// closestFromTable skip this profile outright when the input's dominant script doesn't match.
+type Language struct {
+	Profile map[string]int
+	Name    string
+	Tag     language.Tag
+	Scripts []Script `json:",omitempty"`
+}
+
+func (l *Language) GetName() string {
+	return l.Name
+}
+
+func (l *Language) GetTag() language.Tag {
+	return l.Tag
+}
+
+func (l *Language) GetScripts() []Script {
+	return l.Scripts
+}
+
+func (l *Language) GetProfile() map[string]int {
+	return l.Profile
+}
+
+func (l *Language) CompareTo(lazyLookupMap func() map[string]int, originaltext string) DetectionResult {
+	score := DefaultScorer.Score(l.Profile, lazyLookupMap())
+	return DetectionResult{Name: l.Name, Confidence: int(score * 100)}
+}
+
+// DetectionResult represents the result from comparing 2 Profiles. It includes the confidence which is basically the
+// the relative distance between the two profiles.
+type DetectionResult struct {
+	Name       string
+	Confidence int
+	// Score is the raw value a Scorer produced for this result, higher meaning more similar. It is
+	// only populated when the Detector that produced this result has a Scorer configured; it is
+	// the zero value otherwise, since the legacy CompareTo path doesn't compute one.
+	Score float64
+}
+
+// ResByConf represents an array of DetectionResult and can be sorted by Confidence.
+type ResByConf []DetectionResult
+
+func (a ResByConf) Len() int           { return len(a) }
+func (a ResByConf) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a ResByConf) Less(i, j int) bool { return a[i].Confidence > a[j].Confidence }
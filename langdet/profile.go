@@ -0,0 +1,13 @@
+package langdet
+
+// BuildProfile reconstructs a rank map (as used by Language.Profile) from parallel,
+// alphabetically sorted token/rank slices. It exists so that both the generated
+// default_profiles.go and the standalone packages under langdet/profiles/ (see cmd/genprofiles)
+// can turn their Go-literal tables into a Language without decoding anything at startup.
+func BuildProfile(tokens []string, ranks []uint16) map[string]int {
+	profile := make(map[string]int, len(tokens))
+	for i, token := range tokens {
+		profile[token] = int(ranks[i])
+	}
+	return profile
+}
@@ -0,0 +1,1994 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/arabic.txt; DO NOT EDIT.
+
+// Package ar provides the pre-built arabic langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package ar
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+	"____\xd8",
+	"____\xd9",
+	"___\xd8",
+	"___أ",
+	"___إ",
+	"___ا",
+	"___ب",
+	"___ت",
+	"___ث",
+	"___ج",
+	"___ح",
+	"___خ",
+	"___س",
+	"___ص",
+	"___ع",
+	"___\xd9",
+	"___ف",
+	"___ق",
+	"___ك",
+	"___ل",
+	"___م",
+	"___ن",
+	"___ه",
+	"___و",
+	"___ي",
+	"__\xd8",
+	"__أ",
+	"__أ\xd9",
+	"__إ",
+	"__إ\xd9",
+	"__ا",
+	"__ا\xd9",
+	"__ب",
+	"__ب\xd8",
+	"__ب\xd9",
+	"__ت",
+	"__ت\xd8",
+	"__ت\xd9",
+	"__ث",
+	"__ث\xd8",
+	"__ث\xd9",
+	"__ج",
+	"__ج\xd8",
+	"__ج\xd9",
+	"__ح",
+	"__ح\xd8",
+	"__خ",
+	"__خ\xd9",
+	"__س",
+	"__س\xd9",
+	"__ص",
+	"__ص\xd9",
+	"__ع",
+	"__ع\xd9",
+	"__\xd9",
+	"__ف",
+	"__ف\xd9",
+	"__ق",
+	"__ق\xd8",
+	"__ك",
+	"__ك\xd8",
+	"__ل",
+	"__ل\xd8",
+	"__ل\xd9",
+	"__م",
+	"__م\xd8",
+	"__م\xd9",
+	"__ن",
+	"__ن\xd8",
+	"__ن\xd9",
+	"__ه",
+	"__ه\xd8",
+	"__و",
+	"__و\xd8",
+	"__و\xd9",
+	"__ي",
+	"__ي\xd8",
+	"__ي\xd9",
+	"_\xd8",
+	"_أ",
+	"_أ\xd9",
+	"_أن",
+	"_أو",
+	"_أي",
+	"_إ",
+	"_إ\xd9",
+	"_إل",
+	"_ا",
+	"_ا\xd9",
+	"_ال",
+	"_ب",
+	"_ب\xd8",
+	"_بت",
+	"_بث",
+	"_بح",
+	"_بص",
+	"_ب\xd9",
+	"_بم",
+	"_بن",
+	"_به",
+	"_ت",
+	"_ت\xd8",
+	"_تر",
+	"_تس",
+	"_تع",
+	"_ت\xd9",
+	"_تك",
+	"_تم",
+	"_ث",
+	"_ث\xd8",
+	"_ثا",
+	"_ث\xd9",
+	"_ثم",
+	"_ج",
+	"_ج\xd8",
+	"_جا",
+	"_جد",
+	"_ج\xd9",
+	"_جم",
+	"_ح",
+	"_ح\xd8",
+	"_حت",
+	"_خ",
+	"_خ\xd9",
+	"_خل",
+	"_س",
+	"_س\xd9",
+	"_سو",
+	"_ص",
+	"_ص\xd9",
+	"_صف",
+	"_ع",
+	"_ع\xd9",
+	"_عل",
+	"_عي",
+	"_\xd9",
+	"_ف",
+	"_ف\xd9",
+	"_في",
+	"_ق",
+	"_ق\xd8",
+	"_قص",
+	"_ك",
+	"_ك\xd8",
+	"_كب",
+	"_كت",
+	"_كش",
+	"_ل",
+	"_ل\xd8",
+	"_لغ",
+	"_ل\xd9",
+	"_لل",
+	"_م",
+	"_م\xd8",
+	"_مد",
+	"_مر",
+	"_مع",
+	"_م\xd9",
+	"_مق",
+	"_مك",
+	"_مل",
+	"_من",
+	"_ن",
+	"_ن\xd8",
+	"_نص",
+	"_ن\xd9",
+	"_نف",
+	"_ه",
+	"_ه\xd8",
+	"_هذ",
+	"_و",
+	"_و\xd8",
+	"_وا",
+	"_وت",
+	"_و\xd9",
+	"_وي",
+	"_ي",
+	"_ي\xd8",
+	"_يح",
+	"_ي\xd9",
+	"_يك",
+	"_يم",
+	"\x81",
+	"\x81\xd8",
+	"\x81ح",
+	"\x81ح\xd8",
+	"\x81حة",
+	"\x81س",
+	"\x81س\xd9",
+	"\x81سه",
+	"\x81\xd9",
+	"\x81ك",
+	"\x81ك\xd8",
+	"\x81كر",
+	"\x81ي",
+	"\x81ي_",
+	"\x81ي__",
+	"\x81ي\xd9",
+	"\x81يه",
+	"\x82",
+	"\x82\xd8",
+	"\x82ا",
+	"\x82ا\xd8",
+	"\x82ار",
+	"\x82ص",
+	"\x82ص\xd9",
+	"\x82صي",
+	"\x82ط",
+	"\x82ط\xd8",
+	"\x82طع",
+	"\x82\xd9",
+	"\x82و",
+	"\x82و\xd9",
+	"\x82ول",
+	"\x83",
+	"\x83\xd8",
+	"\x83ب",
+	"\x83ب\xd9",
+	"\x83بي",
+	"\x83ت",
+	"\x83ت\xd8",
+	"\x83تا",
+	"\x83ت\xd9",
+	"\x83تو",
+	"\x83ر",
+	"\x83ر\xd8",
+	"\x83را",
+	"\x83ش",
+	"\x83ش\xd9",
+	"\x83شف",
+	"\x83\xd9",
+	"\x83ف",
+	"\x83ف\xd9",
+	"\x83في",
+	"\x84",
+	"\x84\xd8",
+	"\x84أ",
+	"\x84أ\xd9",
+	"\x84أق",
+	"\x84أك",
+	"\x84ا",
+	"\x84ا\xd9",
+	"\x84ال",
+	"\x84ب",
+	"\x84ب\xd8",
+	"\x84بص",
+	"\x84ت",
+	"\x84ت\xd9",
+	"\x84تك",
+	"\x84ج",
+	"\x84ج\xd9",
+	"\x84جي",
+	"\x84ح",
+	"\x84ح\xd8",
+	"\x84حر",
+	"\x84س",
+	"\x84س\xd9",
+	"\x84سل",
+	"\x84ع",
+	"\x84ع\xd8",
+	"\x84عد",
+	"\x84غ",
+	"\x84غ\xd8",
+	"\x84غا",
+	"\x84غة",
+	"\x84\xd9",
+	"\x84ف",
+	"\x84ف\xd8",
+	"\x84فا",
+	"\x84ف\xd9",
+	"\x84فك",
+	"\x84ق",
+	"\x84ق\xd8",
+	"\x84قص",
+	"\x84ك",
+	"\x84ك\xd8",
+	"\x84كت",
+	"\x84كش",
+	"\x84ل",
+	"\x84ل\xd8",
+	"\x84لع",
+	"\x84لغ",
+	"\x84ل\xd9",
+	"\x84لن",
+	"\x84م",
+	"\x84م\xd9",
+	"\x84مق",
+	"\x84ن",
+	"\x84ن\xd8",
+	"\x84نص",
+	"\x84نظ",
+	"\x84نغ",
+	"\x84ي",
+	"\x84ي\xd9",
+	"\x84يو",
+	"\x85",
+	"\x85\xd8",
+	"\x85د",
+	"\x85د\xd9",
+	"\x85دم",
+	"\x85ر",
+	"\x85ر\xd8",
+	"\x85رب",
+	"\x85ع",
+	"\x85ع_",
+	"\x85ع__",
+	"\x85ع\xd8",
+	"\x85عر",
+	"\x85ع\xd9",
+	"\x85عق",
+	"\x85عي",
+	"\x85\xd9",
+	"\x85ق",
+	"\x85ق\xd8",
+	"\x85قا",
+	"\x85قط",
+	"\x85ك",
+	"\x85ك\xd8",
+	"\x85كت",
+	"\x85ك\xd9",
+	"\x85كن",
+	"\x85ل",
+	"\x85ل\xd8",
+	"\x85لة",
+	"\x85ل\xd9",
+	"\x85لف",
+	"\x85ن",
+	"\x85ن_",
+	"\x85ن__",
+	"\x85ي",
+	"\x85ي\xd9",
+	"\x85يل",
+	"\x86",
+	"\x86\xd8",
+	"\x86ا",
+	"\x86ا\xd8",
+	"\x86اء",
+	"\x86ص",
+	"\x86ص_",
+	"\x86ص__",
+	"\x86ط",
+	"\x86ط\xd8",
+	"\x86طب",
+	"\x86ظ",
+	"\x86ظ\xd8",
+	"\x86ظا",
+	"\x86ظ\xd9",
+	"\x86ظم",
+	"\x86غ",
+	"\x86غ\xd8",
+	"\x86غر",
+	"\x86\xd9",
+	"\x86ف",
+	"\x86ف\xd8",
+	"\x86فس",
+	"\x86م",
+	"\x86م\xd8",
+	"\x86ما",
+	"\x87",
+	"\x87\xd8",
+	"\x87ذ",
+	"\x87ذ\xd9",
+	"\x87ذه",
+	"\x88",
+	"\x88\xd8",
+	"\x88ا",
+	"\x88ا\xd8",
+	"\x88اء",
+	"\x88اح",
+	"\x88ا\xd9",
+	"\x88ال",
+	"\x88ت",
+	"\x88ت\xd9",
+	"\x88تن",
+	"\x88\xd9",
+	"\x88ي",
+	"\x88ي\xd8",
+	"\x88يب",
+	"\x88ي\xd9",
+	"\x88يق",
+	"\x8a",
+	"\x8a\xd8",
+	"\x8aح",
+	"\x8aح\xd9",
+	"\x8aحل",
+	"\x8aر",
+	"\x8aر\xd9",
+	"\x8aرً",
+	"\x8a\xd9",
+	"\x8aق",
+	"\x8aق\xd8",
+	"\x8aقا",
+	"\x8aك",
+	"\x8aك\xd9",
+	"\x8aكف",
+	"\x8aم",
+	"\x8aم\xd9",
+	"\x8aمك",
+	"\x8aن",
+	"\x8aن\xd8",
+	"\x8aنة",
+	"\x8aو",
+	"\x8aو\xd9",
+	"\x8aوم",
+	"\xa3",
+	"\xa3\xd9",
+	"\xa3ك",
+	"\xa3ك\xd8",
+	"\xa3كث",
+	"\xa3ن",
+	"\xa3ن\xd8",
+	"\xa3نظ",
+	"\xa3ن\xd9",
+	"\xa3نم",
+	"\xa3و",
+	"\xa3و_",
+	"\xa3و__",
+	"\xa3ي",
+	"\xa3ي_",
+	"\xa3ي__",
+	"\xa5",
+	"\xa5\xd9",
+	"\xa5ل",
+	"\xa5ل\xd9",
+	"\xa5لى",
+	"\xa7",
+	"\xa7\xd8",
+	"\xa7ب",
+	"\xa7ب\xd8",
+	"\xa7بت",
+	"\xa7ح",
+	"\xa7ح\xd8",
+	"\xa7حد",
+	"\xa7ر",
+	"\xa7ر\xd9",
+	"\xa7رن",
+	"\xa7\xd9",
+	"\xa7ل",
+	"\xa7ل\xd8",
+	"\xa7لأ",
+	"\xa7لب",
+	"\xa7لت",
+	"\xa7لج",
+	"\xa7لح",
+	"\xa7ل\xd9",
+	"\xa7لف",
+	"\xa7لق",
+	"\xa7لك",
+	"\xa7لل",
+	"\xa7لم",
+	"\xa7لن",
+	"\xa7لي",
+	"\xa8",
+	"\xa8\xd8",
+	"\xa8ت",
+	"\xa8ت\xd8",
+	"\xa8تر",
+	"\xa8ث",
+	"\xa8ث\xd9",
+	"\xa8ثق",
+	"\xa8ح",
+	"\xa8ح\xd8",
+	"\xa8حث",
+	"\xa8ص",
+	"\xa8ص\xd9",
+	"\xa8صم",
+	"\xa8\xd9",
+	"\xa8م",
+	"\xa8م\xd8",
+	"\xa8ما",
+	"\xa8ن",
+	"\xa8ن\xd8",
+	"\xa8نا",
+	"\xa8ه",
+	"\xa8ه\xd8",
+	"\xa8هذ",
+	"\xa8ي",
+	"\xa8ي\xd8",
+	"\xa8ير",
+	"\xaa",
+	"\xaa\xd8",
+	"\xaaا",
+	"\xaaا\xd8",
+	"\xaaاب",
+	"\xaaر",
+	"\xaaر\xd8",
+	"\xaaرت",
+	"\xaaرد",
+	"\xaaس",
+	"\xaaس\xd9",
+	"\xaaسل",
+	"\xaaسم",
+	"\xaaع",
+	"\xaaع\xd8",
+	"\xaaعت",
+	"\xaa\xd9",
+	"\xaaك",
+	"\xaaك\xd8",
+	"\xaaكر",
+	"\xaaم",
+	"\xaaم\xd9",
+	"\xaaمي",
+	"\xaaن",
+	"\xaaن\xd8",
+	"\xaaنط",
+	"\xaaو",
+	"\xaaو\xd8",
+	"\xaaوب",
+	"\xab",
+	"\xab\xd8",
+	"\xabا",
+	"\xabا\xd8",
+	"\xabاب",
+	"\xab\xd9",
+	"\xabق",
+	"\xabق\xd8",
+	"\xabقة",
+	"\xabم",
+	"\xabم_",
+	"\xabم__",
+	"\xac",
+	"\xac\xd8",
+	"\xacا",
+	"\xacا\xd8",
+	"\xacاء",
+	"\xacد",
+	"\xacد\xd9",
+	"\xacدي",
+	"\xacدً",
+	"\xac\xd9",
+	"\xacم",
+	"\xacم\xd9",
+	"\xacمل",
+	"\xacي",
+	"\xacي\xd8",
+	"\xacيد",
+	"\xad",
+	"\xad\xd8",
+	"\xadت",
+	"\xadت\xd9",
+	"\xadتى",
+	"\xadر",
+	"\xadر\xd9",
+	"\xadرو",
+	"\xad\xd9",
+	"\xadل",
+	"\xadل\xd9",
+	"\xadلل",
+	"\xae",
+	"\xae\xd9",
+	"\xaeل",
+	"\xaeل\xd8",
+	"\xaeلا",
+	"\xaf",
+	"\xaf\xd9",
+	"\xafم",
+	"\xafم\xd8",
+	"\xafمج",
+	"\xafي",
+	"\xafي\xd8",
+	"\xafيد",
+	"\xafً",
+	"\xafً\xd8",
+	"\xafًا",
+	"\xb1",
+	"\xb1\xd8",
+	"\xb1ا",
+	"\xb1ا\xd8",
+	"\xb1ار",
+	"\xb1ب",
+	"\xb1ب\xd8",
+	"\xb1بع",
+	"\xb1ت",
+	"\xb1ت\xd9",
+	"\xb1تي",
+	"\xb1\xd9",
+	"\xb1و",
+	"\xb1و\xd9",
+	"\xb1وف",
+	"\xb3",
+	"\xb3\xd9",
+	"\xb3ل",
+	"\xb3ل\xd8",
+	"\xb3لس",
+	"\xb3م",
+	"\xb3م\xd9",
+	"\xb3مى",
+	"\xb3و",
+	"\xb3و\xd8",
+	"\xb3وا",
+	"\xb5",
+	"\xb5\xd9",
+	"\xb5ف",
+	"\xb5ف\xd8",
+	"\xb5فح",
+	"\xb5م",
+	"\xb5م\xd8",
+	"\xb5مة",
+	"\xb5ي",
+	"\xb5ي\xd8",
+	"\xb5ير",
+	"\xb7",
+	"\xb7\xd8",
+	"\xb7ع",
+	"\xb7ع\xd9",
+	"\xb7عً",
+	"\xb9",
+	"\xb9\xd8",
+	"\xb9ت",
+	"\xb9ت\xd9",
+	"\xb9تم",
+	"\xb9د",
+	"\xb9د\xd9",
+	"\xb9دي",
+	"\xb9ر",
+	"\xb9ر\xd9",
+	"\xb9رو",
+	"\xb9\xd9",
+	"\xb9ق",
+	"\xb9ق\xd9",
+	"\xb9قو",
+	"\xb9ل",
+	"\xb9ل\xd9",
+	"\xb9لى",
+	"\xb9ي",
+	"\xb9ي\xd9",
+	"\xb9ين",
+	"\xba",
+	"\xba\xd8",
+	"\xbaر",
+	"\xbaر\xd8",
+	"\xbaرا",
+	"\xd8",
+	"أ",
+	"أ\xd9",
+	"أق",
+	"أق\xd9",
+	"أك",
+	"أك\xd8",
+	"أن",
+	"أن\xd8",
+	"أن\xd9",
+	"أو",
+	"أو_",
+	"أي",
+	"أي_",
+	"إ",
+	"إ\xd9",
+	"إل",
+	"إل\xd9",
+	"ا",
+	"ا\xd8",
+	"اء",
+	"اء_",
+	"اب",
+	"اب\xd8",
+	"اح",
+	"اح\xd8",
+	"ار",
+	"ار\xd9",
+	"ا\xd9",
+	"ال",
+	"ال\xd8",
+	"ال\xd9",
+	"ب",
+	"ب\xd8",
+	"بت",
+	"بت\xd8",
+	"بث",
+	"بث\xd9",
+	"بح",
+	"بح\xd8",
+	"بص",
+	"بص\xd9",
+	"ب\xd9",
+	"بم",
+	"بم\xd8",
+	"بن",
+	"بن\xd8",
+	"به",
+	"به\xd8",
+	"بي",
+	"بي\xd8",
+	"ت",
+	"ت\xd8",
+	"تا",
+	"تا\xd8",
+	"تر",
+	"تر\xd8",
+	"تس",
+	"تس\xd9",
+	"تع",
+	"تع\xd8",
+	"ت\xd9",
+	"تك",
+	"تك\xd8",
+	"تم",
+	"تم\xd8",
+	"تم\xd9",
+	"تن",
+	"تن\xd8",
+	"تو",
+	"تو\xd8",
+	"تى",
+	"تى_",
+	"تي",
+	"تي\xd8",
+	"ث",
+	"ث\xd8",
+	"ثا",
+	"ثا\xd8",
+	"ث\xd9",
+	"ثق",
+	"ثق\xd8",
+	"ثم",
+	"ثم_",
+	"ج",
+	"ج\xd8",
+	"جا",
+	"جا\xd8",
+	"جد",
+	"جد\xd9",
+	"ج\xd9",
+	"جم",
+	"جم\xd9",
+	"جي",
+	"جي\xd8",
+	"ح",
+	"ح\xd8",
+	"حت",
+	"حت\xd9",
+	"حث",
+	"حث_",
+	"حد",
+	"حد\xd8",
+	"حر",
+	"حر\xd9",
+	"ح\xd9",
+	"حل",
+	"حل\xd9",
+	"خ",
+	"خ\xd9",
+	"خل",
+	"خل\xd8",
+	"د",
+	"د\xd9",
+	"دم",
+	"دم\xd8",
+	"دي",
+	"دي\xd8",
+	"دً",
+	"دً\xd8",
+	"ذ",
+	"ذ\xd9",
+	"ذه",
+	"ذه_",
+	"ر",
+	"ر\xd8",
+	"را",
+	"را\xd8",
+	"را\xd9",
+	"رب",
+	"رب\xd8",
+	"رت",
+	"رت\xd9",
+	"رد",
+	"رد\xd8",
+	"ر\xd9",
+	"رو",
+	"رو\xd9",
+	"س",
+	"س\xd9",
+	"سل",
+	"سل\xd8",
+	"سم",
+	"سم\xd9",
+	"سه",
+	"سه\xd8",
+	"سو",
+	"سو\xd8",
+	"ش",
+	"ش\xd9",
+	"شف",
+	"شف_",
+	"ص",
+	"ص\xd9",
+	"صف",
+	"صف\xd8",
+	"صم",
+	"صم\xd8",
+	"صي",
+	"صي\xd8",
+	"ط",
+	"ط\xd8",
+	"طع",
+	"طع\xd9",
+	"ظ",
+	"ظ\xd9",
+	"ظم",
+	"ظم\xd8",
+	"ع",
+	"ع\xd8",
+	"عت",
+	"عت\xd9",
+	"عد",
+	"عد\xd9",
+	"عر",
+	"عر\xd9",
+	"ع\xd9",
+	"عق",
+	"عق\xd9",
+	"عل",
+	"عل\xd9",
+	"عي",
+	"عي\xd9",
+	"غ",
+	"غ\xd8",
+	"غة",
+	"غة_",
+	"غر",
+	"غر\xd8",
+	"\xd9",
+	"ف",
+	"ف\xd8",
+	"فا",
+	"فا\xd8",
+	"فح",
+	"فح\xd8",
+	"فس",
+	"فس\xd9",
+	"ف\xd9",
+	"فك",
+	"فك\xd8",
+	"في",
+	"في_",
+	"في\xd9",
+	"ق",
+	"ق\xd8",
+	"قا",
+	"قا\xd8",
+	"قص",
+	"قص\xd9",
+	"قط",
+	"قط\xd8",
+	"ق\xd9",
+	"قو",
+	"قو\xd9",
+	"ك",
+	"ك\xd8",
+	"كب",
+	"كب\xd9",
+	"كت",
+	"كت\xd8",
+	"كت\xd9",
+	"كر",
+	"كر\xd8",
+	"كش",
+	"كش\xd9",
+	"ك\xd9",
+	"كف",
+	"كف\xd9",
+	"ل",
+	"ل\xd8",
+	"لأ",
+	"لأ\xd9",
+	"لا",
+	"لا\xd9",
+	"لب",
+	"لب\xd8",
+	"لت",
+	"لت\xd9",
+	"لج",
+	"لج\xd9",
+	"لح",
+	"لح\xd8",
+	"لس",
+	"لس\xd9",
+	"لع",
+	"لع\xd8",
+	"لغ",
+	"لغ\xd8",
+	"ل\xd9",
+	"لف",
+	"لف\xd8",
+	"لف\xd9",
+	"لق",
+	"لق\xd8",
+	"لك",
+	"لك\xd8",
+	"لل",
+	"لل\xd8",
+	"لل\xd9",
+	"لم",
+	"لم\xd9",
+	"لن",
+	"لن\xd8",
+	"لى",
+	"لى_",
+	"لي",
+	"لي\xd9",
+	"م",
+	"م\xd8",
+	"ما",
+	"ما_",
+	"ما\xd8",
+	"مج",
+	"مج\xd8",
+	"مد",
+	"مد\xd9",
+	"مر",
+	"مر\xd8",
+	"مع",
+	"مع_",
+	"مع\xd8",
+	"مع\xd9",
+	"م\xd9",
+	"مق",
+	"مق\xd8",
+	"مك",
+	"مك\xd8",
+	"مك\xd9",
+	"مل",
+	"مل\xd8",
+	"مل\xd9",
+	"من",
+	"من_",
+	"مي",
+	"مي\xd9",
+	"ن",
+	"ن\xd8",
+	"نا",
+	"نا\xd8",
+	"نص",
+	"نص_",
+	"نط",
+	"نط\xd8",
+	"نظ",
+	"نظ\xd8",
+	"نظ\xd9",
+	"نغ",
+	"نغ\xd8",
+	"ن\xd9",
+	"نف",
+	"نف\xd8",
+	"نم",
+	"نم\xd8",
+	"ه",
+	"ه\xd8",
+	"هذ",
+	"هذ\xd9",
+	"و",
+	"و\xd8",
+	"وا",
+	"وا\xd8",
+	"وا\xd9",
+	"وت",
+	"وت\xd9",
+	"و\xd9",
+	"وم",
+	"وم\xd9",
+	"وي",
+	"وي\xd8",
+	"وي\xd9",
+	"ي",
+	"ي\xd8",
+	"يب",
+	"يب_",
+	"يح",
+	"يح\xd9",
+	"ير",
+	"ير\xd8",
+	"ير\xd9",
+	"ي\xd9",
+	"يق",
+	"يق\xd8",
+	"يك",
+	"يك\xd9",
+	"يم",
+	"يم\xd9",
+	"ين",
+	"ين\xd8",
+	"يه",
+	"يه_",
+	"يو",
+	"يو\xd9",
+}
+
+var ranks = []uint16{
+	6,
+	10,
+	5,
+	121,
+	397,
+	27,
+	78,
+	92,
+	396,
+	157,
+	985,
+	984,
+	983,
+	982,
+	395,
+	9,
+	156,
+	394,
+	220,
+	155,
+	30,
+	393,
+	981,
+	120,
+	219,
+	4,
+	119,
+	118,
+	392,
+	391,
+	26,
+	25,
+	77,
+	154,
+	218,
+	91,
+	153,
+	390,
+	389,
+	980,
+	979,
+	152,
+	217,
+	978,
+	977,
+	976,
+	975,
+	974,
+	973,
+	972,
+	971,
+	970,
+	388,
+	387,
+	8,
+	151,
+	150,
+	386,
+	385,
+	216,
+	215,
+	149,
+	969,
+	214,
+	29,
+	90,
+	41,
+	384,
+	968,
+	967,
+	966,
+	965,
+	117,
+	213,
+	383,
+	212,
+	964,
+	382,
+	3,
+	116,
+	115,
+	381,
+	380,
+	963,
+	379,
+	378,
+	377,
+	24,
+	23,
+	22,
+	76,
+	148,
+	962,
+	961,
+	960,
+	959,
+	211,
+	958,
+	957,
+	956,
+	89,
+	147,
+	955,
+	376,
+	954,
+	375,
+	953,
+	952,
+	374,
+	951,
+	950,
+	949,
+	948,
+	146,
+	210,
+	947,
+	373,
+	946,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	933,
+	372,
+	371,
+	932,
+	931,
+	7,
+	145,
+	144,
+	143,
+	370,
+	369,
+	368,
+	209,
+	208,
+	930,
+	929,
+	928,
+	142,
+	927,
+	926,
+	207,
+	206,
+	28,
+	88,
+	925,
+	924,
+	141,
+	40,
+	367,
+	923,
+	922,
+	60,
+	366,
+	921,
+	920,
+	919,
+	918,
+	917,
+	916,
+	915,
+	114,
+	205,
+	365,
+	914,
+	364,
+	363,
+	204,
+	913,
+	912,
+	362,
+	911,
+	910,
+	75,
+	361,
+	909,
+	908,
+	907,
+	906,
+	905,
+	904,
+	113,
+	903,
+	902,
+	901,
+	140,
+	203,
+	202,
+	900,
+	899,
+	74,
+	87,
+	360,
+	359,
+	358,
+	201,
+	200,
+	199,
+	898,
+	897,
+	896,
+	895,
+	894,
+	893,
+	892,
+	73,
+	86,
+	891,
+	890,
+	889,
+	198,
+	357,
+	356,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	877,
+	12,
+	36,
+	355,
+	354,
+	876,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	353,
+	352,
+	351,
+	862,
+	861,
+	860,
+	859,
+	858,
+	857,
+	197,
+	196,
+	856,
+	350,
+	31,
+	349,
+	855,
+	854,
+	853,
+	852,
+	851,
+	850,
+	849,
+	348,
+	347,
+	848,
+	847,
+	112,
+	139,
+	846,
+	195,
+	845,
+	844,
+	843,
+	842,
+	841,
+	111,
+	110,
+	346,
+	840,
+	345,
+	839,
+	838,
+	837,
+	16,
+	85,
+	836,
+	835,
+	834,
+	833,
+	832,
+	831,
+	138,
+	830,
+	829,
+	828,
+	827,
+	344,
+	826,
+	825,
+	33,
+	194,
+	193,
+	343,
+	824,
+	342,
+	823,
+	822,
+	821,
+	820,
+	341,
+	819,
+	818,
+	817,
+	816,
+	59,
+	58,
+	57,
+	815,
+	814,
+	813,
+	48,
+	72,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	340,
+	803,
+	802,
+	801,
+	800,
+	339,
+	338,
+	337,
+	336,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	335,
+	334,
+	333,
+	332,
+	331,
+	84,
+	137,
+	192,
+	330,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	329,
+	328,
+	786,
+	785,
+	784,
+	783,
+	71,
+	327,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	109,
+	776,
+	775,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	83,
+	82,
+	761,
+	760,
+	759,
+	326,
+	758,
+	757,
+	756,
+	755,
+	325,
+	324,
+	323,
+	754,
+	753,
+	752,
+	322,
+	321,
+	320,
+	319,
+	318,
+	15,
+	191,
+	751,
+	750,
+	749,
+	748,
+	747,
+	746,
+	745,
+	744,
+	743,
+	21,
+	20,
+	56,
+	317,
+	742,
+	316,
+	741,
+	315,
+	39,
+	740,
+	739,
+	314,
+	313,
+	738,
+	136,
+	737,
+	47,
+	108,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	728,
+	312,
+	311,
+	310,
+	135,
+	727,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	717,
+	716,
+	42,
+	81,
+	715,
+	714,
+	713,
+	309,
+	308,
+	712,
+	711,
+	307,
+	306,
+	710,
+	709,
+	708,
+	707,
+	706,
+	107,
+	305,
+	304,
+	303,
+	705,
+	704,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	190,
+	696,
+	695,
+	694,
+	693,
+	302,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	106,
+	189,
+	686,
+	685,
+	684,
+	301,
+	300,
+	683,
+	682,
+	299,
+	681,
+	680,
+	679,
+	678,
+	677,
+	676,
+	134,
+	188,
+	675,
+	674,
+	673,
+	298,
+	297,
+	296,
+	672,
+	671,
+	670,
+	669,
+	668,
+	667,
+	666,
+	665,
+	664,
+	187,
+	186,
+	663,
+	662,
+	661,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	133,
+	185,
+	654,
+	653,
+	652,
+	651,
+	650,
+	649,
+	648,
+	647,
+	646,
+	645,
+	644,
+	643,
+	642,
+	184,
+	183,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	635,
+	634,
+	633,
+	132,
+	131,
+	632,
+	631,
+	630,
+	629,
+	628,
+	627,
+	295,
+	294,
+	293,
+	626,
+	625,
+	624,
+	623,
+	622,
+	70,
+	182,
+	621,
+	620,
+	619,
+	618,
+	617,
+	616,
+	615,
+	614,
+	613,
+	130,
+	612,
+	611,
+	610,
+	609,
+	608,
+	607,
+	292,
+	291,
+	290,
+	289,
+	288,
+	287,
+	286,
+	285,
+	2,
+	69,
+	68,
+	606,
+	605,
+	604,
+	603,
+	284,
+	602,
+	601,
+	283,
+	282,
+	600,
+	599,
+	281,
+	280,
+	279,
+	278,
+	14,
+	105,
+	598,
+	597,
+	596,
+	595,
+	594,
+	593,
+	277,
+	276,
+	19,
+	18,
+	55,
+	38,
+	46,
+	104,
+	592,
+	591,
+	590,
+	589,
+	588,
+	587,
+	275,
+	274,
+	129,
+	586,
+	585,
+	584,
+	583,
+	582,
+	581,
+	580,
+	579,
+	35,
+	67,
+	273,
+	272,
+	271,
+	270,
+	269,
+	268,
+	578,
+	577,
+	54,
+	267,
+	266,
+	265,
+	576,
+	575,
+	574,
+	573,
+	572,
+	571,
+	570,
+	569,
+	568,
+	567,
+	181,
+	566,
+	565,
+	564,
+	264,
+	563,
+	562,
+	561,
+	560,
+	103,
+	180,
+	559,
+	558,
+	263,
+	262,
+	261,
+	557,
+	556,
+	555,
+	554,
+	80,
+	102,
+	553,
+	552,
+	551,
+	550,
+	549,
+	548,
+	260,
+	259,
+	547,
+	546,
+	545,
+	544,
+	543,
+	542,
+	541,
+	179,
+	178,
+	540,
+	539,
+	538,
+	537,
+	536,
+	535,
+	534,
+	533,
+	532,
+	531,
+	66,
+	79,
+	177,
+	530,
+	258,
+	529,
+	528,
+	527,
+	526,
+	525,
+	524,
+	523,
+	522,
+	521,
+	101,
+	100,
+	257,
+	256,
+	520,
+	519,
+	518,
+	517,
+	516,
+	515,
+	514,
+	513,
+	512,
+	511,
+	99,
+	98,
+	510,
+	509,
+	508,
+	507,
+	176,
+	175,
+	506,
+	505,
+	504,
+	503,
+	502,
+	501,
+	500,
+	499,
+	65,
+	174,
+	498,
+	497,
+	496,
+	495,
+	494,
+	493,
+	128,
+	492,
+	491,
+	490,
+	489,
+	255,
+	254,
+	173,
+	172,
+	488,
+	487,
+	253,
+	252,
+	1,
+	53,
+	171,
+	486,
+	485,
+	484,
+	483,
+	482,
+	481,
+	97,
+	480,
+	479,
+	127,
+	170,
+	478,
+	52,
+	64,
+	169,
+	168,
+	167,
+	166,
+	477,
+	476,
+	475,
+	474,
+	473,
+	45,
+	51,
+	472,
+	471,
+	165,
+	251,
+	470,
+	250,
+	249,
+	248,
+	247,
+	469,
+	468,
+	467,
+	11,
+	34,
+	246,
+	245,
+	466,
+	465,
+	464,
+	463,
+	244,
+	243,
+	462,
+	461,
+	242,
+	241,
+	460,
+	459,
+	458,
+	457,
+	126,
+	125,
+	17,
+	240,
+	456,
+	455,
+	454,
+	453,
+	239,
+	238,
+	96,
+	124,
+	452,
+	451,
+	450,
+	95,
+	94,
+	164,
+	163,
+	449,
+	448,
+	13,
+	44,
+	237,
+	447,
+	446,
+	445,
+	444,
+	443,
+	442,
+	441,
+	440,
+	123,
+	439,
+	438,
+	236,
+	32,
+	162,
+	161,
+	235,
+	437,
+	436,
+	234,
+	435,
+	434,
+	50,
+	49,
+	433,
+	432,
+	43,
+	63,
+	431,
+	430,
+	429,
+	428,
+	427,
+	426,
+	233,
+	425,
+	424,
+	232,
+	231,
+	230,
+	423,
+	422,
+	421,
+	420,
+	229,
+	228,
+	227,
+	226,
+	62,
+	122,
+	160,
+	225,
+	419,
+	418,
+	417,
+	159,
+	416,
+	415,
+	224,
+	414,
+	413,
+	37,
+	93,
+	412,
+	411,
+	410,
+	409,
+	158,
+	223,
+	408,
+	61,
+	407,
+	406,
+	405,
+	404,
+	403,
+	402,
+	222,
+	221,
+	401,
+	400,
+	399,
+	398,
+}
+
+// Profile is the pre-built arabic language profile.
+var Profile = langdet.Language{
+	Name:    "arabic",
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse("ar"),
+	Scripts: []langdet.Script{langdet.ScriptArabic},
+}
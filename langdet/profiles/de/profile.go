@@ -0,0 +1,3328 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/german.txt; DO NOT EDIT.
+
+// Package de provides the pre-built german langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package de
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+	"B",
+	"Bu",
+	"Buc",
+	"Buch",
+	"Buch_",
+	"Buchs",
+	"D",
+	"Da",
+	"Das",
+	"Das_",
+	"Das__",
+	"Di",
+	"Die",
+	"Die_",
+	"Die__",
+	"Du",
+	"Dur",
+	"Durc",
+	"Durch",
+	"E",
+	"Er",
+	"Erk",
+	"Erke",
+	"Erken",
+	"F",
+	"Fi",
+	"Fin",
+	"Fing",
+	"Finge",
+	"G",
+	"Gr",
+	"Gra",
+	"Gram",
+	"Gramm",
+	"Gu",
+	"Gut",
+	"Gute",
+	"Gute_",
+	"H",
+	"H\xc3",
+	"Hä",
+	"Häu",
+	"Häuf",
+	"N",
+	"N_",
+	"N__",
+	"N___",
+	"N____",
+	"P",
+	"Pr",
+	"Pro",
+	"Prof",
+	"Profi",
+	"R",
+	"Ra",
+	"Ran",
+	"Rang",
+	"Rangf",
+	"S",
+	"Sa",
+	"Sat",
+	"Satz",
+	"Satz_",
+	"Si",
+	"Sic",
+	"Sich",
+	"Siche",
+	"Sp",
+	"Spr",
+	"Spra",
+	"Sprac",
+	"Su",
+	"Suc",
+	"Such",
+	"Suchl",
+	"Sy",
+	"Sys",
+	"Syst",
+	"Syste",
+	"T",
+	"Te",
+	"Tex",
+	"Text",
+	"Text_",
+	"Texta",
+	"Textb",
+	"W",
+	"We",
+	"Web",
+	"Webs",
+	"Webse",
+	"_B",
+	"_Bu",
+	"_Buc",
+	"_Buch",
+	"_D",
+	"_Da",
+	"_Das",
+	"_Das_",
+	"_Di",
+	"_Die",
+	"_Die_",
+	"_Du",
+	"_Dur",
+	"_Durc",
+	"_E",
+	"_Er",
+	"_Erk",
+	"_Erke",
+	"_F",
+	"_Fi",
+	"_Fin",
+	"_Fing",
+	"_G",
+	"_Gr",
+	"_Gra",
+	"_Gram",
+	"_Gu",
+	"_Gut",
+	"_Gute",
+	"_H",
+	"_H\xc3",
+	"_Hä",
+	"_Häu",
+	"_N",
+	"_N_",
+	"_N__",
+	"_N___",
+	"_P",
+	"_Pr",
+	"_Pro",
+	"_Prof",
+	"_R",
+	"_Ra",
+	"_Ran",
+	"_Rang",
+	"_S",
+	"_Sa",
+	"_Sat",
+	"_Satz",
+	"_Si",
+	"_Sic",
+	"_Sich",
+	"_Sp",
+	"_Spr",
+	"_Spra",
+	"_Su",
+	"_Suc",
+	"_Such",
+	"_Sy",
+	"_Sys",
+	"_Syst",
+	"_T",
+	"_Te",
+	"_Tex",
+	"_Text",
+	"_W",
+	"_We",
+	"_Web",
+	"_Webs",
+	"__B",
+	"__Bu",
+	"__Buc",
+	"__D",
+	"__Da",
+	"__Das",
+	"__Di",
+	"__Die",
+	"__Du",
+	"__Dur",
+	"__E",
+	"__Er",
+	"__Erk",
+	"__F",
+	"__Fi",
+	"__Fin",
+	"__G",
+	"__Gr",
+	"__Gra",
+	"__Gu",
+	"__Gut",
+	"__H",
+	"__H\xc3",
+	"__Hä",
+	"__N",
+	"__N_",
+	"__N__",
+	"__P",
+	"__Pr",
+	"__Pro",
+	"__R",
+	"__Ra",
+	"__Ran",
+	"__S",
+	"__Sa",
+	"__Sat",
+	"__Si",
+	"__Sic",
+	"__Sp",
+	"__Spr",
+	"__Su",
+	"__Suc",
+	"__Sy",
+	"__Sys",
+	"__T",
+	"__Te",
+	"__Tex",
+	"__W",
+	"__We",
+	"__Web",
+	"___B",
+	"___Bu",
+	"___D",
+	"___Da",
+	"___Di",
+	"___Du",
+	"___E",
+	"___Er",
+	"___F",
+	"___Fi",
+	"___G",
+	"___Gr",
+	"___Gu",
+	"___H",
+	"___H\xc3",
+	"___N",
+	"___N_",
+	"___P",
+	"___Pr",
+	"___R",
+	"___Ra",
+	"___S",
+	"___Sa",
+	"___Si",
+	"___Sp",
+	"___Su",
+	"___Sy",
+	"___T",
+	"___Te",
+	"___W",
+	"___We",
+	"____B",
+	"____D",
+	"____E",
+	"____F",
+	"____G",
+	"____H",
+	"____N",
+	"____P",
+	"____R",
+	"____S",
+	"____T",
+	"____W",
+	"____a",
+	"____b",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____h",
+	"____i",
+	"____j",
+	"____k",
+	"____m",
+	"____n",
+	"____o",
+	"____s",
+	"____u",
+	"____v",
+	"____w",
+	"____z",
+	"___a",
+	"___al",
+	"___an",
+	"___au",
+	"___b",
+	"___be",
+	"___d",
+	"___di",
+	"___e",
+	"___ei",
+	"___er",
+	"___f",
+	"___fu",
+	"___g",
+	"___gl",
+	"___gr",
+	"___gu",
+	"___h",
+	"___h\xc3",
+	"___i",
+	"___in",
+	"___j",
+	"___je",
+	"___k",
+	"___ka",
+	"___ko",
+	"___ku",
+	"___m",
+	"___mi",
+	"___n",
+	"___ne",
+	"___nu",
+	"___o",
+	"___od",
+	"___s",
+	"___se",
+	"___so",
+	"___sp",
+	"___st",
+	"___u",
+	"___un",
+	"___v",
+	"___ve",
+	"___vi",
+	"___vo",
+	"___w",
+	"___wi",
+	"___z",
+	"___zu",
+	"__a",
+	"__al",
+	"__all",
+	"__an",
+	"__ang",
+	"__au",
+	"__aus",
+	"__b",
+	"__be",
+	"__bei",
+	"__bek",
+	"__d",
+	"__di",
+	"__die",
+	"__e",
+	"__ei",
+	"__ein",
+	"__er",
+	"__ers",
+	"__f",
+	"__fu",
+	"__fun",
+	"__g",
+	"__gl",
+	"__gle",
+	"__gr",
+	"__gro",
+	"__gu",
+	"__gut",
+	"__h",
+	"__h\xc3",
+	"__hä",
+	"__i",
+	"__in",
+	"__in_",
+	"__j",
+	"__je",
+	"__jed",
+	"__k",
+	"__ka",
+	"__kan",
+	"__ko",
+	"__kom",
+	"__ku",
+	"__kur",
+	"__m",
+	"__mi",
+	"__mit",
+	"__n",
+	"__ne",
+	"__neu",
+	"__nu",
+	"__nut",
+	"__o",
+	"__od",
+	"__ode",
+	"__s",
+	"__se",
+	"__seh",
+	"__sei",
+	"__sel",
+	"__so",
+	"__sog",
+	"__sp",
+	"__sp\xc3",
+	"__st",
+	"__sta",
+	"__u",
+	"__un",
+	"__und",
+	"__unt",
+	"__v",
+	"__ve",
+	"__ver",
+	"__vi",
+	"__vie",
+	"__vo",
+	"__von",
+	"__w",
+	"__wi",
+	"__wie",
+	"__z",
+	"__zu",
+	"__zu_",
+	"_a",
+	"_al",
+	"_all",
+	"_allt",
+	"_an",
+	"_ang",
+	"_ange",
+	"_au",
+	"_aus",
+	"_ausr",
+	"_b",
+	"_be",
+	"_bei",
+	"_bei_",
+	"_bek",
+	"_beka",
+	"_d",
+	"_di",
+	"_die",
+	"_die_",
+	"_dies",
+	"_e",
+	"_ei",
+	"_ein",
+	"_ein_",
+	"_eine",
+	"_einz",
+	"_er",
+	"_ers",
+	"_erst",
+	"_f",
+	"_fu",
+	"_fun",
+	"_funk",
+	"_g",
+	"_gl",
+	"_gle",
+	"_glei",
+	"_gr",
+	"_gro",
+	"_gro\xc3",
+	"_gu",
+	"_gut",
+	"_gut_",
+	"_h",
+	"_h\xc3",
+	"_hä",
+	"_häu",
+	"_i",
+	"_in",
+	"_in_",
+	"_in__",
+	"_j",
+	"_je",
+	"_jed",
+	"_jede",
+	"_k",
+	"_ka",
+	"_kan",
+	"_kann",
+	"_ko",
+	"_kom",
+	"_komp",
+	"_ku",
+	"_kur",
+	"_kurz",
+	"_m",
+	"_mi",
+	"_mit",
+	"_mit_",
+	"_n",
+	"_ne",
+	"_neu",
+	"_neue",
+	"_nu",
+	"_nut",
+	"_nutz",
+	"_o",
+	"_od",
+	"_ode",
+	"_oder",
+	"_s",
+	"_se",
+	"_seh",
+	"_sehr",
+	"_sei",
+	"_sein",
+	"_sel",
+	"_selb",
+	"_selt",
+	"_so",
+	"_sog",
+	"_soge",
+	"_sp",
+	"_sp\xc3",
+	"_spä",
+	"_st",
+	"_sta",
+	"_stab",
+	"_u",
+	"_un",
+	"_und",
+	"_und_",
+	"_unt",
+	"_unte",
+	"_v",
+	"_ve",
+	"_ver",
+	"_verg",
+	"_vi",
+	"_vie",
+	"_viel",
+	"_vo",
+	"_von",
+	"_von_",
+	"_w",
+	"_wi",
+	"_wie",
+	"_wied",
+	"_z",
+	"_zu",
+	"_zu_",
+	"_zu__",
+	"a",
+	"ab",
+	"abd",
+	"abdr",
+	"abdru",
+	"abe",
+	"aben",
+	"abenf",
+	"abenm",
+	"abi",
+	"abil",
+	"abile",
+	"abs",
+	"absc",
+	"absch",
+	"ac",
+	"ach",
+	"ache",
+	"ache_",
+	"achen",
+	"acher",
+	"ak",
+	"akt",
+	"akte",
+	"akten",
+	"al",
+	"all",
+	"allt",
+	"allt\xc3",
+	"am",
+	"amm",
+	"amme",
+	"amme_",
+	"an",
+	"ang",
+	"ange",
+	"angem",
+	"angf",
+	"angfo",
+	"ann",
+	"ann_",
+	"ann__",
+	"annt",
+	"annte",
+	"as",
+	"as_",
+	"as__",
+	"as___",
+	"at",
+	"atz",
+	"atz_",
+	"atz__",
+	"au",
+	"aus",
+	"ausr",
+	"ausre",
+	"auss",
+	"aussc",
+	"b",
+	"bd",
+	"bdr",
+	"bdru",
+	"bdruc",
+	"be",
+	"bei",
+	"bei_",
+	"bei__",
+	"beis",
+	"beisp",
+	"bek",
+	"beka",
+	"bekan",
+	"ben",
+	"benf",
+	"benfo",
+	"benm",
+	"benmu",
+	"bi",
+	"bil",
+	"bile",
+	"biler",
+	"bs",
+	"bsc",
+	"bsch",
+	"bschn",
+	"bse",
+	"bsei",
+	"bseit",
+	"bst",
+	"bst_",
+	"bst__",
+	"c",
+	"ch",
+	"ch_",
+	"ch__",
+	"ch___",
+	"che",
+	"che_",
+	"che__",
+	"chen",
+	"chen_",
+	"chend",
+	"cher",
+	"cherh",
+	"cherk",
+	"chl",
+	"chle",
+	"chlei",
+	"chn",
+	"chni",
+	"chnit",
+	"chs",
+	"chst",
+	"chsta",
+	"cht",
+	"cht_",
+	"cht__",
+	"ck",
+	"ck_",
+	"ck__",
+	"ck___",
+	"d",
+	"d_",
+	"d__",
+	"d___",
+	"d____",
+	"de",
+	"dem",
+	"dem_",
+	"dem__",
+	"der",
+	"der_",
+	"der__",
+	"derk",
+	"derke",
+	"di",
+	"die",
+	"die_",
+	"die__",
+	"dies",
+	"diese",
+	"dr",
+	"dru",
+	"druc",
+	"druck",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"eb",
+	"ebs",
+	"ebse",
+	"ebsei",
+	"ed",
+	"ede",
+	"edem",
+	"edem_",
+	"eder",
+	"ederk",
+	"eh",
+	"ehr",
+	"ehr_",
+	"ehr__",
+	"ehre",
+	"ehren",
+	"ei",
+	"ei_",
+	"ei__",
+	"ei___",
+	"eic",
+	"eich",
+	"eich_",
+	"eiche",
+	"eicht",
+	"ein",
+	"ein_",
+	"ein__",
+	"eine",
+	"eine_",
+	"einem",
+	"einen",
+	"einer",
+	"einz",
+	"einze",
+	"eis",
+	"eisp",
+	"eispi",
+	"eist",
+	"eiste",
+	"eit",
+	"eit_",
+	"eit__",
+	"eite",
+	"eite_",
+	"ek",
+	"eka",
+	"ekan",
+	"ekann",
+	"el",
+	"el_",
+	"el__",
+	"el___",
+	"elb",
+	"elbs",
+	"elbst",
+	"ele",
+	"eler",
+	"eler_",
+	"ell",
+	"elle",
+	"ellen",
+	"eln",
+	"elne",
+	"elnen",
+	"elt",
+	"elte",
+	"elten",
+	"em",
+	"em_",
+	"em__",
+	"em___",
+	"eme",
+	"eme_",
+	"eme__",
+	"emes",
+	"emess",
+	"en",
+	"en_",
+	"en__",
+	"en___",
+	"ena",
+	"enan",
+	"enann",
+	"end",
+	"end_",
+	"end__",
+	"ene",
+	"ener",
+	"ener_",
+	"enf",
+	"enfo",
+	"enfol",
+	"enm",
+	"enmu",
+	"enmus",
+	"enn",
+	"ennu",
+	"ennun",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"era",
+	"erab",
+	"erabd",
+	"erg",
+	"ergl",
+	"ergle",
+	"erh",
+	"erhe",
+	"erhei",
+	"erk",
+	"erke",
+	"erkeh",
+	"erken",
+	"ers",
+	"erst",
+	"erste",
+	"ersu",
+	"ersuc",
+	"ert",
+	"ert_",
+	"ert__",
+	"es",
+	"ese",
+	"eser",
+	"eser_",
+	"ess",
+	"esse",
+	"essen",
+	"eu",
+	"eue",
+	"euen",
+	"euen_",
+	"ex",
+	"ext",
+	"ext_",
+	"ext__",
+	"exta",
+	"extab",
+	"extau",
+	"extb",
+	"extbe",
+	"f",
+	"fi",
+	"fig",
+	"fig_",
+	"fig__",
+	"figk",
+	"figke",
+	"fil",
+	"file",
+	"filen",
+	"fo",
+	"fol",
+	"folg",
+	"folge",
+	"fu",
+	"fun",
+	"funk",
+	"funkt",
+	"g",
+	"g_",
+	"g__",
+	"g___",
+	"g____",
+	"ge",
+	"ge_",
+	"ge__",
+	"ge___",
+	"gem",
+	"geme",
+	"gemes",
+	"gen",
+	"gen_",
+	"gen__",
+	"gena",
+	"genan",
+	"ger",
+	"gera",
+	"gerab",
+	"gf",
+	"gfo",
+	"gfol",
+	"gfolg",
+	"gk",
+	"gke",
+	"gkei",
+	"gkeit",
+	"gl",
+	"gle",
+	"glei",
+	"gleic",
+	"gli",
+	"glic",
+	"glich",
+	"gr",
+	"gro",
+	"gro\xc3",
+	"groß",
+	"gs",
+	"gss",
+	"gssy",
+	"gssys",
+	"gu",
+	"gut",
+	"gut_",
+	"gut__",
+	"h",
+	"h_",
+	"h__",
+	"h___",
+	"h____",
+	"he",
+	"he_",
+	"he__",
+	"he___",
+	"hei",
+	"heit",
+	"heit_",
+	"hen",
+	"hen_",
+	"hen__",
+	"hend",
+	"hend_",
+	"her",
+	"herh",
+	"herhe",
+	"herk",
+	"herke",
+	"hl",
+	"hle",
+	"hlei",
+	"hleis",
+	"hn",
+	"hni",
+	"hnit",
+	"hnitt",
+	"hr",
+	"hr_",
+	"hr__",
+	"hr___",
+	"hre",
+	"hren",
+	"hren_",
+	"hs",
+	"hst",
+	"hsta",
+	"hstab",
+	"ht",
+	"ht_",
+	"ht__",
+	"ht___",
+	"h\xc3",
+	"hä",
+	"häu",
+	"häuf",
+	"i",
+	"i_",
+	"i__",
+	"i___",
+	"i____",
+	"ic",
+	"ich",
+	"ich_",
+	"ich__",
+	"iche",
+	"ichen",
+	"icher",
+	"icht",
+	"icht_",
+	"ie",
+	"ie_",
+	"ie__",
+	"ie___",
+	"ied",
+	"iede",
+	"ieder",
+	"iel",
+	"iel_",
+	"iel__",
+	"iele",
+	"ieler",
+	"ier",
+	"iert",
+	"iert_",
+	"ies",
+	"iese",
+	"ieser",
+	"ig",
+	"ig_",
+	"ig__",
+	"ig___",
+	"igk",
+	"igke",
+	"igkei",
+	"il",
+	"ile",
+	"ilen",
+	"ilen_",
+	"iler",
+	"iler_",
+	"in",
+	"in_",
+	"in__",
+	"in___",
+	"ine",
+	"ine_",
+	"ine__",
+	"inem",
+	"inem_",
+	"inen",
+	"inen_",
+	"iner",
+	"iner_",
+	"ing",
+	"inge",
+	"inger",
+	"inz",
+	"inze",
+	"inzel",
+	"io",
+	"ion",
+	"ioni",
+	"ionie",
+	"is",
+	"isp",
+	"ispi",
+	"ispie",
+	"ist",
+	"iste",
+	"iste_",
+	"it",
+	"it_",
+	"it__",
+	"it___",
+	"ite",
+	"ite_",
+	"ite__",
+	"itt",
+	"itt_",
+	"itt__",
+	"itte",
+	"itten",
+	"j",
+	"je",
+	"jed",
+	"jede",
+	"jedem",
+	"k",
+	"k_",
+	"k__",
+	"k___",
+	"k____",
+	"ka",
+	"kan",
+	"kann",
+	"kann_",
+	"kannt",
+	"ke",
+	"keh",
+	"kehr",
+	"kehre",
+	"kei",
+	"keit",
+	"keit_",
+	"ken",
+	"kenn",
+	"kennu",
+	"ko",
+	"kom",
+	"komp",
+	"kompa",
+	"kt",
+	"kte",
+	"kten",
+	"kten_",
+	"kti",
+	"ktio",
+	"ktion",
+	"ku",
+	"kur",
+	"kurz",
+	"kurze",
+	"l",
+	"l_",
+	"l__",
+	"l___",
+	"l____",
+	"lb",
+	"lbs",
+	"lbst",
+	"lbst_",
+	"le",
+	"lei",
+	"leic",
+	"leich",
+	"leis",
+	"leist",
+	"len",
+	"len_",
+	"len__",
+	"ler",
+	"ler_",
+	"ler__",
+	"lg",
+	"lge",
+	"lge_",
+	"lge__",
+	"lgen",
+	"lgen_",
+	"li",
+	"lic",
+	"lich",
+	"liche",
+	"ll",
+	"lle",
+	"llen",
+	"llen_",
+	"llt",
+	"llt\xc3",
+	"lltä",
+	"ln",
+	"lne",
+	"lnen",
+	"lnen_",
+	"lt",
+	"lte",
+	"lten",
+	"lten_",
+	"lt\xc3",
+	"ltä",
+	"ltäg",
+	"m",
+	"m_",
+	"m__",
+	"m___",
+	"m____",
+	"me",
+	"me_",
+	"me__",
+	"me___",
+	"mes",
+	"mess",
+	"messe",
+	"mi",
+	"mit",
+	"mit_",
+	"mit__",
+	"mm",
+	"mme",
+	"mme_",
+	"mme__",
+	"mp",
+	"mpa",
+	"mpak",
+	"mpakt",
+	"mu",
+	"mus",
+	"must",
+	"muste",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"nan",
+	"nann",
+	"nannt",
+	"nd",
+	"nd_",
+	"nd__",
+	"nd___",
+	"ne",
+	"ne_",
+	"ne__",
+	"ne___",
+	"nem",
+	"nem_",
+	"nem__",
+	"nen",
+	"nen_",
+	"nen__",
+	"ner",
+	"ner_",
+	"ner__",
+	"neu",
+	"neue",
+	"neuen",
+	"nf",
+	"nfo",
+	"nfol",
+	"nfolg",
+	"ng",
+	"ng_",
+	"ng__",
+	"ng___",
+	"nge",
+	"ngem",
+	"ngeme",
+	"nger",
+	"ngera",
+	"ngf",
+	"ngfo",
+	"ngfol",
+	"ngs",
+	"ngss",
+	"ngssy",
+	"ni",
+	"nie",
+	"nier",
+	"niert",
+	"nit",
+	"nitt",
+	"nitt_",
+	"nitte",
+	"nk",
+	"nkt",
+	"nkti",
+	"nktio",
+	"nm",
+	"nmu",
+	"nmus",
+	"nmust",
+	"nn",
+	"nn_",
+	"nn__",
+	"nn___",
+	"nnt",
+	"nnte",
+	"nnte_",
+	"nnten",
+	"nnu",
+	"nnun",
+	"nnung",
+	"nt",
+	"nte",
+	"nte_",
+	"nte__",
+	"nten",
+	"nten_",
+	"nter",
+	"nters",
+	"nu",
+	"nun",
+	"nung",
+	"nung_",
+	"nungs",
+	"nut",
+	"nutz",
+	"nutze",
+	"nz",
+	"nze",
+	"nzel",
+	"nzeln",
+	"o",
+	"od",
+	"ode",
+	"oder",
+	"oder_",
+	"of",
+	"ofi",
+	"ofil",
+	"ofile",
+	"og",
+	"oge",
+	"ogen",
+	"ogena",
+	"ol",
+	"olg",
+	"olge",
+	"olge_",
+	"olgen",
+	"om",
+	"omp",
+	"ompa",
+	"ompak",
+	"on",
+	"on_",
+	"on__",
+	"on___",
+	"oni",
+	"onie",
+	"onier",
+	"o\xc3",
+	"oß",
+	"oße",
+	"oßen",
+	"p",
+	"pa",
+	"pak",
+	"pakt",
+	"pakte",
+	"pi",
+	"pie",
+	"piel",
+	"piel_",
+	"pr",
+	"pra",
+	"prac",
+	"prach",
+	"p\xc3",
+	"pä",
+	"pät",
+	"päte",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"rab",
+	"rabd",
+	"rabdr",
+	"rac",
+	"rach",
+	"rache",
+	"ram",
+	"ramm",
+	"ramme",
+	"rc",
+	"rch",
+	"rch_",
+	"rch__",
+	"re",
+	"rei",
+	"reic",
+	"reich",
+	"ren",
+	"ren_",
+	"ren__",
+	"rg",
+	"rgl",
+	"rgle",
+	"rglei",
+	"rh",
+	"rhe",
+	"rhei",
+	"rheit",
+	"rk",
+	"rke",
+	"rkeh",
+	"rkehr",
+	"rken",
+	"rkenn",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"ro\xc3",
+	"roß",
+	"roße",
+	"rs",
+	"rst",
+	"rste",
+	"rstel",
+	"rsu",
+	"rsuc",
+	"rsuch",
+	"rt",
+	"rt_",
+	"rt__",
+	"rt___",
+	"ru",
+	"ruc",
+	"ruck",
+	"ruck_",
+	"rz",
+	"rze",
+	"rze_",
+	"rze__",
+	"rzen",
+	"rzen_",
+	"s",
+	"s_",
+	"s__",
+	"s___",
+	"s____",
+	"sc",
+	"sch",
+	"schn",
+	"schni",
+	"se",
+	"seh",
+	"sehr",
+	"sehr_",
+	"sei",
+	"sein",
+	"seine",
+	"seit",
+	"seite",
+	"sel",
+	"selb",
+	"selbs",
+	"selt",
+	"selte",
+	"sen",
+	"sene",
+	"sener",
+	"ser",
+	"ser_",
+	"ser__",
+	"so",
+	"sog",
+	"soge",
+	"sogen",
+	"sp",
+	"spi",
+	"spie",
+	"spiel",
+	"sp\xc3",
+	"spä",
+	"spät",
+	"sr",
+	"sre",
+	"srei",
+	"sreic",
+	"ss",
+	"ssc",
+	"ssch",
+	"sschn",
+	"sse",
+	"ssen",
+	"ssene",
+	"ssy",
+	"ssys",
+	"ssyst",
+	"st",
+	"st_",
+	"st__",
+	"st___",
+	"sta",
+	"stab",
+	"stabe",
+	"stabi",
+	"ste",
+	"ste_",
+	"ste__",
+	"stel",
+	"stell",
+	"stem",
+	"stem_",
+	"steme",
+	"ster",
+	"ster_",
+	"su",
+	"suc",
+	"such",
+	"sucht",
+	"sy",
+	"sys",
+	"syst",
+	"syste",
+	"t",
+	"t_",
+	"t__",
+	"t___",
+	"t____",
+	"ta",
+	"tab",
+	"tabe",
+	"taben",
+	"tabi",
+	"tabil",
+	"tabs",
+	"tabsc",
+	"tau",
+	"taus",
+	"tauss",
+	"tb",
+	"tbe",
+	"tbei",
+	"tbeis",
+	"te",
+	"te_",
+	"te__",
+	"te___",
+	"tel",
+	"tell",
+	"telle",
+	"tem",
+	"tem_",
+	"tem__",
+	"teme",
+	"teme_",
+	"ten",
+	"ten_",
+	"ten__",
+	"ter",
+	"ter_",
+	"ter__",
+	"ters",
+	"tersu",
+	"ti",
+	"tio",
+	"tion",
+	"tioni",
+	"tt",
+	"tt_",
+	"tt__",
+	"tt___",
+	"tte",
+	"tten",
+	"tten_",
+	"tz",
+	"tz_",
+	"tz__",
+	"tz___",
+	"tze",
+	"tzen",
+	"tzen_",
+	"t\xc3",
+	"tä",
+	"täg",
+	"tägl",
+	"u",
+	"u_",
+	"u__",
+	"u___",
+	"u____",
+	"uc",
+	"uch",
+	"uch_",
+	"uch__",
+	"uchl",
+	"uchle",
+	"uchs",
+	"uchst",
+	"ucht",
+	"ucht_",
+	"uck",
+	"uck_",
+	"uck__",
+	"ue",
+	"uen",
+	"uen_",
+	"uen__",
+	"uf",
+	"ufi",
+	"ufig",
+	"ufig_",
+	"ufigk",
+	"un",
+	"und",
+	"und_",
+	"und__",
+	"ung",
+	"ung_",
+	"ung__",
+	"ungs",
+	"ungss",
+	"unk",
+	"unkt",
+	"unkti",
+	"unt",
+	"unte",
+	"unter",
+	"ur",
+	"urc",
+	"urch",
+	"urch_",
+	"urz",
+	"urze",
+	"urze_",
+	"urzen",
+	"us",
+	"usr",
+	"usre",
+	"usrei",
+	"uss",
+	"ussc",
+	"ussch",
+	"ust",
+	"uste",
+	"uster",
+	"ut",
+	"ut_",
+	"ut__",
+	"ut___",
+	"ute",
+	"ute_",
+	"ute__",
+	"utz",
+	"utze",
+	"utzen",
+	"v",
+	"ve",
+	"ver",
+	"verg",
+	"vergl",
+	"vi",
+	"vie",
+	"viel",
+	"viele",
+	"vo",
+	"von",
+	"von_",
+	"von__",
+	"w",
+	"wi",
+	"wie",
+	"wied",
+	"wiede",
+	"x",
+	"xt",
+	"xt_",
+	"xt__",
+	"xt___",
+	"xta",
+	"xtab",
+	"xtabs",
+	"xtau",
+	"xtaus",
+	"xtb",
+	"xtbe",
+	"xtbei",
+	"y",
+	"ys",
+	"yst",
+	"yste",
+	"ystem",
+	"z",
+	"z_",
+	"z__",
+	"z___",
+	"z____",
+	"ze",
+	"ze_",
+	"ze__",
+	"ze___",
+	"zel",
+	"zeln",
+	"zelne",
+	"zen",
+	"zen_",
+	"zen__",
+	"zu",
+	"zu_",
+	"zu__",
+	"zu___",
+	"\x9f",
+	"\x9fe",
+	"\x9fen",
+	"\x9fen_",
+	"\x9fen__",
+	"\xa4",
+	"\xa4g",
+	"\xa4gl",
+	"\xa4gli",
+	"\xa4glic",
+	"\xa4t",
+	"\xa4te",
+	"\xa4ter",
+	"\xa4ter_",
+	"\xa4u",
+	"\xa4uf",
+	"\xa4ufi",
+	"\xa4ufig",
+	"\xc3",
+	"ß",
+	"ße",
+	"ßen",
+	"ßen_",
+	"ä",
+	"äg",
+	"ägl",
+	"ägli",
+	"ät",
+	"äte",
+	"äter",
+	"äu",
+	"äuf",
+	"äufi",
+}
+
+var ranks = []uint16{
+	323,
+	322,
+	321,
+	320,
+	1652,
+	566,
+	319,
+	1651,
+	1650,
+	1649,
+	1648,
+	1647,
+	1646,
+	1645,
+	1644,
+	1643,
+	1642,
+	1641,
+	1640,
+	1639,
+	1638,
+	1637,
+	1636,
+	1635,
+	1634,
+	1633,
+	1632,
+	1631,
+	1630,
+	318,
+	565,
+	564,
+	563,
+	562,
+	1629,
+	1628,
+	1627,
+	1626,
+	1625,
+	1624,
+	1623,
+	1622,
+	1621,
+	561,
+	560,
+	559,
+	558,
+	557,
+	1620,
+	1619,
+	1618,
+	1617,
+	1616,
+	1615,
+	1614,
+	1613,
+	1612,
+	1611,
+	70,
+	1610,
+	1609,
+	1608,
+	1607,
+	1606,
+	1605,
+	1604,
+	1603,
+	181,
+	180,
+	179,
+	178,
+	1602,
+	1601,
+	1600,
+	1599,
+	1598,
+	1597,
+	1596,
+	1595,
+	177,
+	176,
+	175,
+	174,
+	1594,
+	556,
+	1593,
+	1592,
+	1591,
+	1590,
+	1589,
+	1588,
+	317,
+	316,
+	315,
+	314,
+	313,
+	1587,
+	1586,
+	1585,
+	1584,
+	1583,
+	1582,
+	1581,
+	1580,
+	1579,
+	1578,
+	1577,
+	1576,
+	1575,
+	1574,
+	1573,
+	1572,
+	1571,
+	312,
+	555,
+	554,
+	553,
+	1570,
+	1569,
+	1568,
+	1567,
+	1566,
+	1565,
+	1564,
+	552,
+	551,
+	550,
+	549,
+	1563,
+	1562,
+	1561,
+	1560,
+	1559,
+	1558,
+	1557,
+	1556,
+	69,
+	1555,
+	1554,
+	1553,
+	1552,
+	1551,
+	1550,
+	173,
+	172,
+	171,
+	1549,
+	1548,
+	1547,
+	1546,
+	1545,
+	1544,
+	170,
+	169,
+	168,
+	167,
+	1543,
+	1542,
+	1541,
+	1540,
+	311,
+	310,
+	309,
+	308,
+	1539,
+	1538,
+	1537,
+	1536,
+	1535,
+	1534,
+	1533,
+	1532,
+	1531,
+	1530,
+	1529,
+	1528,
+	307,
+	548,
+	547,
+	1527,
+	1526,
+	1525,
+	1524,
+	1523,
+	546,
+	545,
+	544,
+	1522,
+	1521,
+	1520,
+	1519,
+	1518,
+	1517,
+	68,
+	1516,
+	1515,
+	1514,
+	1513,
+	166,
+	165,
+	1512,
+	1511,
+	1510,
+	1509,
+	164,
+	163,
+	162,
+	1508,
+	1507,
+	1506,
+	306,
+	305,
+	304,
+	1505,
+	1504,
+	1503,
+	1502,
+	1501,
+	1500,
+	1499,
+	303,
+	543,
+	1498,
+	1497,
+	1496,
+	542,
+	541,
+	1495,
+	1494,
+	1493,
+	1492,
+	67,
+	1491,
+	1490,
+	161,
+	1489,
+	1488,
+	160,
+	159,
+	1487,
+	1486,
+	302,
+	301,
+	1485,
+	1484,
+	300,
+	1483,
+	540,
+	1482,
+	1481,
+	66,
+	158,
+	1480,
+	299,
+	298,
+	297,
+	49,
+	1479,
+	296,
+	1478,
+	539,
+	1477,
+	106,
+	295,
+	538,
+	1476,
+	78,
+	294,
+	157,
+	1475,
+	1474,
+	293,
+	1473,
+	1472,
+	1471,
+	292,
+	291,
+	290,
+	289,
+	48,
+	55,
+	1470,
+	1469,
+	1468,
+	288,
+	1467,
+	1466,
+	1465,
+	1464,
+	1463,
+	537,
+	536,
+	1462,
+	1461,
+	105,
+	1460,
+	1459,
+	287,
+	286,
+	285,
+	535,
+	1458,
+	1457,
+	1456,
+	1455,
+	77,
+	156,
+	1454,
+	1453,
+	1452,
+	284,
+	283,
+	155,
+	534,
+	1451,
+	1450,
+	1449,
+	1448,
+	1447,
+	1446,
+	282,
+	1445,
+	1444,
+	1443,
+	1442,
+	1441,
+	1440,
+	281,
+	280,
+	533,
+	1439,
+	279,
+	278,
+	277,
+	47,
+	54,
+	53,
+	1438,
+	1437,
+	1436,
+	1435,
+	1434,
+	276,
+	1433,
+	1432,
+	1431,
+	1430,
+	1429,
+	1428,
+	1427,
+	1426,
+	1425,
+	532,
+	531,
+	530,
+	1424,
+	1423,
+	1422,
+	104,
+	1421,
+	1420,
+	1419,
+	1418,
+	275,
+	274,
+	273,
+	272,
+	271,
+	529,
+	1417,
+	1416,
+	1415,
+	1414,
+	1413,
+	1412,
+	1411,
+	76,
+	154,
+	1410,
+	1409,
+	528,
+	1408,
+	1407,
+	1406,
+	1405,
+	1404,
+	1403,
+	270,
+	269,
+	527,
+	1402,
+	153,
+	526,
+	525,
+	1401,
+	1400,
+	1399,
+	1398,
+	1397,
+	1396,
+	1395,
+	1394,
+	1393,
+	1392,
+	268,
+	1391,
+	1390,
+	1389,
+	1388,
+	1387,
+	1386,
+	1385,
+	1384,
+	1383,
+	267,
+	266,
+	524,
+	523,
+	1382,
+	1381,
+	265,
+	264,
+	263,
+	522,
+	1380,
+	46,
+	52,
+	51,
+	1379,
+	65,
+	1378,
+	1377,
+	1376,
+	1375,
+	1374,
+	1373,
+	1372,
+	1371,
+	262,
+	1370,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	1364,
+	1363,
+	1362,
+	1361,
+	1360,
+	1359,
+	1358,
+	521,
+	520,
+	519,
+	518,
+	1357,
+	1356,
+	1355,
+	1354,
+	103,
+	1353,
+	1352,
+	1351,
+	1350,
+	1349,
+	1348,
+	261,
+	260,
+	259,
+	258,
+	257,
+	256,
+	255,
+	517,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	1342,
+	1341,
+	1340,
+	1339,
+	1338,
+	75,
+	152,
+	1337,
+	1336,
+	1335,
+	1334,
+	516,
+	1333,
+	1332,
+	1331,
+	1330,
+	1329,
+	1328,
+	1327,
+	1326,
+	1325,
+	1324,
+	1323,
+	254,
+	253,
+	515,
+	514,
+	1322,
+	1321,
+	151,
+	513,
+	512,
+	511,
+	1320,
+	1319,
+	1318,
+	1317,
+	1316,
+	1315,
+	1314,
+	1313,
+	1312,
+	1311,
+	1310,
+	1309,
+	1308,
+	1307,
+	15,
+	102,
+	1306,
+	1305,
+	1304,
+	510,
+	509,
+	1303,
+	1302,
+	1301,
+	1300,
+	1299,
+	1298,
+	1297,
+	1296,
+	150,
+	149,
+	148,
+	508,
+	1295,
+	1294,
+	1293,
+	1292,
+	1291,
+	1290,
+	1289,
+	1288,
+	1287,
+	1286,
+	507,
+	506,
+	505,
+	504,
+	101,
+	503,
+	1285,
+	1284,
+	1283,
+	1282,
+	252,
+	1281,
+	1280,
+	502,
+	501,
+	1279,
+	1278,
+	1277,
+	1276,
+	1275,
+	1274,
+	1273,
+	1272,
+	500,
+	499,
+	1271,
+	1270,
+	1269,
+	1268,
+	45,
+	1267,
+	1266,
+	1265,
+	1264,
+	86,
+	251,
+	498,
+	497,
+	1263,
+	1262,
+	1261,
+	1260,
+	1259,
+	496,
+	1258,
+	1257,
+	1256,
+	1255,
+	1254,
+	1253,
+	1252,
+	1251,
+	250,
+	1250,
+	1249,
+	1248,
+	1247,
+	1246,
+	1245,
+	1244,
+	1243,
+	1242,
+	20,
+	22,
+	249,
+	248,
+	247,
+	64,
+	495,
+	494,
+	147,
+	246,
+	1241,
+	493,
+	1240,
+	1239,
+	1238,
+	1237,
+	1236,
+	492,
+	491,
+	490,
+	489,
+	488,
+	487,
+	486,
+	485,
+	484,
+	1235,
+	1234,
+	1233,
+	1232,
+	50,
+	245,
+	244,
+	243,
+	242,
+	241,
+	1231,
+	1230,
+	1229,
+	483,
+	1228,
+	1227,
+	1226,
+	1225,
+	240,
+	239,
+	482,
+	481,
+	1224,
+	1223,
+	1222,
+	1221,
+	1220,
+	1219,
+	1,
+	28,
+	27,
+	26,
+	25,
+	1218,
+	1217,
+	1216,
+	1215,
+	480,
+	479,
+	1214,
+	1213,
+	1212,
+	1211,
+	478,
+	477,
+	1210,
+	1209,
+	1208,
+	1207,
+	14,
+	476,
+	475,
+	474,
+	146,
+	145,
+	1206,
+	473,
+	1205,
+	44,
+	1204,
+	1203,
+	60,
+	1202,
+	472,
+	471,
+	144,
+	1201,
+	1200,
+	470,
+	1199,
+	1198,
+	1197,
+	1196,
+	238,
+	469,
+	468,
+	1195,
+	1194,
+	1193,
+	1192,
+	1191,
+	1190,
+	85,
+	1189,
+	1188,
+	1187,
+	1186,
+	1185,
+	1184,
+	1183,
+	1182,
+	1181,
+	1180,
+	1179,
+	1178,
+	1177,
+	1176,
+	1175,
+	1174,
+	1173,
+	1172,
+	84,
+	143,
+	142,
+	141,
+	467,
+	1171,
+	1170,
+	1169,
+	1168,
+	8,
+	19,
+	18,
+	17,
+	1167,
+	1166,
+	1165,
+	1164,
+	1163,
+	1162,
+	1161,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	1155,
+	1154,
+	1153,
+	466,
+	465,
+	464,
+	16,
+	43,
+	42,
+	41,
+	1152,
+	1151,
+	1150,
+	463,
+	462,
+	461,
+	1149,
+	1148,
+	1147,
+	460,
+	459,
+	1146,
+	1145,
+	458,
+	1144,
+	1143,
+	1142,
+	1141,
+	1140,
+	1139,
+	1138,
+	457,
+	1137,
+	1136,
+	1135,
+	1134,
+	1133,
+	1132,
+	1131,
+	1130,
+	1129,
+	1128,
+	140,
+	139,
+	1127,
+	1126,
+	456,
+	1125,
+	1124,
+	1123,
+	1122,
+	83,
+	237,
+	455,
+	1121,
+	1120,
+	1119,
+	1118,
+	1117,
+	1116,
+	1115,
+	454,
+	453,
+	452,
+	451,
+	1114,
+	1113,
+	1112,
+	1111,
+	24,
+	1110,
+	1109,
+	1108,
+	1107,
+	100,
+	1106,
+	1105,
+	1104,
+	1103,
+	1102,
+	1101,
+	450,
+	1100,
+	1099,
+	1098,
+	1097,
+	1096,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	1088,
+	1087,
+	1086,
+	138,
+	236,
+	235,
+	234,
+	1085,
+	1084,
+	1083,
+	1082,
+	1081,
+	1080,
+	1079,
+	1078,
+	1077,
+	1076,
+	1075,
+	1074,
+	1073,
+	1072,
+	1071,
+	13,
+	233,
+	232,
+	231,
+	230,
+	59,
+	449,
+	448,
+	447,
+	1070,
+	1069,
+	1068,
+	137,
+	229,
+	228,
+	1067,
+	1066,
+	446,
+	1065,
+	1064,
+	1063,
+	1062,
+	1061,
+	1060,
+	1059,
+	1058,
+	445,
+	444,
+	443,
+	442,
+	441,
+	1057,
+	1056,
+	1055,
+	1054,
+	1053,
+	1052,
+	440,
+	439,
+	438,
+	437,
+	436,
+	435,
+	434,
+	433,
+	1051,
+	1050,
+	1049,
+	1048,
+	3,
+	432,
+	431,
+	430,
+	429,
+	82,
+	81,
+	1047,
+	1046,
+	136,
+	227,
+	1045,
+	1044,
+	1043,
+	63,
+	226,
+	225,
+	224,
+	1042,
+	1041,
+	1040,
+	428,
+	1039,
+	1038,
+	1037,
+	1036,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	1030,
+	427,
+	1029,
+	1028,
+	1027,
+	1026,
+	1025,
+	1024,
+	426,
+	425,
+	1023,
+	1022,
+	1021,
+	1020,
+	30,
+	223,
+	222,
+	221,
+	58,
+	1019,
+	1018,
+	424,
+	423,
+	422,
+	421,
+	135,
+	134,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	1012,
+	1011,
+	1010,
+	1009,
+	1008,
+	420,
+	1007,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	62,
+	99,
+	98,
+	97,
+	1001,
+	1000,
+	999,
+	419,
+	998,
+	997,
+	996,
+	995,
+	994,
+	993,
+	992,
+	991,
+	990,
+	31,
+	989,
+	988,
+	987,
+	986,
+	418,
+	417,
+	416,
+	985,
+	984,
+	133,
+	983,
+	982,
+	981,
+	980,
+	979,
+	978,
+	415,
+	414,
+	413,
+	977,
+	976,
+	975,
+	974,
+	412,
+	973,
+	972,
+	971,
+	970,
+	969,
+	968,
+	220,
+	219,
+	218,
+	217,
+	21,
+	967,
+	966,
+	965,
+	964,
+	963,
+	962,
+	961,
+	960,
+	61,
+	132,
+	216,
+	215,
+	959,
+	958,
+	411,
+	410,
+	409,
+	408,
+	407,
+	406,
+	405,
+	404,
+	957,
+	956,
+	955,
+	954,
+	953,
+	952,
+	951,
+	950,
+	403,
+	949,
+	948,
+	947,
+	946,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	402,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	23,
+	131,
+	130,
+	129,
+	128,
+	127,
+	214,
+	213,
+	212,
+	933,
+	932,
+	931,
+	211,
+	210,
+	209,
+	208,
+	401,
+	400,
+	399,
+	398,
+	930,
+	929,
+	928,
+	927,
+	926,
+	925,
+	924,
+	923,
+	2,
+	12,
+	11,
+	10,
+	9,
+	922,
+	921,
+	920,
+	919,
+	207,
+	206,
+	205,
+	204,
+	32,
+	918,
+	917,
+	916,
+	397,
+	396,
+	395,
+	203,
+	202,
+	201,
+	96,
+	95,
+	94,
+	915,
+	914,
+	913,
+	912,
+	911,
+	910,
+	909,
+	93,
+	908,
+	907,
+	906,
+	394,
+	905,
+	904,
+	903,
+	902,
+	901,
+	900,
+	899,
+	898,
+	897,
+	896,
+	200,
+	895,
+	894,
+	893,
+	393,
+	392,
+	892,
+	891,
+	890,
+	889,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	92,
+	882,
+	881,
+	880,
+	391,
+	390,
+	879,
+	878,
+	389,
+	388,
+	387,
+	199,
+	198,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	197,
+	386,
+	385,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	57,
+	862,
+	861,
+	860,
+	859,
+	858,
+	857,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	384,
+	383,
+	382,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	381,
+	844,
+	843,
+	842,
+	841,
+	840,
+	839,
+	838,
+	837,
+	836,
+	835,
+	74,
+	834,
+	833,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	126,
+	125,
+	124,
+	123,
+	826,
+	825,
+	824,
+	823,
+	5,
+	40,
+	39,
+	38,
+	37,
+	73,
+	822,
+	821,
+	820,
+	122,
+	121,
+	120,
+	380,
+	379,
+	378,
+	819,
+	818,
+	817,
+	816,
+	377,
+	815,
+	814,
+	813,
+	812,
+	811,
+	810,
+	376,
+	375,
+	374,
+	373,
+	809,
+	808,
+	807,
+	806,
+	196,
+	195,
+	805,
+	804,
+	372,
+	371,
+	370,
+	803,
+	802,
+	801,
+	800,
+	799,
+	798,
+	369,
+	797,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	786,
+	785,
+	784,
+	194,
+	193,
+	783,
+	782,
+	368,
+	367,
+	6,
+	781,
+	780,
+	779,
+	778,
+	366,
+	365,
+	364,
+	363,
+	72,
+	777,
+	776,
+	775,
+	362,
+	774,
+	773,
+	772,
+	771,
+	361,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	757,
+	360,
+	756,
+	755,
+	754,
+	753,
+	752,
+	751,
+	750,
+	749,
+	748,
+	747,
+	192,
+	746,
+	745,
+	744,
+	743,
+	742,
+	741,
+	740,
+	739,
+	738,
+	56,
+	737,
+	736,
+	735,
+	191,
+	190,
+	359,
+	734,
+	91,
+	733,
+	732,
+	731,
+	730,
+	358,
+	729,
+	728,
+	727,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	4,
+	36,
+	35,
+	34,
+	33,
+	90,
+	119,
+	357,
+	356,
+	717,
+	716,
+	715,
+	714,
+	713,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	29,
+	118,
+	117,
+	116,
+	706,
+	705,
+	704,
+	355,
+	703,
+	702,
+	701,
+	700,
+	115,
+	114,
+	113,
+	189,
+	354,
+	353,
+	699,
+	698,
+	697,
+	696,
+	695,
+	694,
+	352,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	351,
+	687,
+	686,
+	685,
+	684,
+	683,
+	682,
+	681,
+	680,
+	679,
+	678,
+	7,
+	677,
+	676,
+	675,
+	674,
+	80,
+	89,
+	673,
+	672,
+	671,
+	670,
+	350,
+	349,
+	669,
+	668,
+	667,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	348,
+	347,
+	346,
+	660,
+	659,
+	79,
+	345,
+	344,
+	343,
+	342,
+	658,
+	657,
+	656,
+	655,
+	654,
+	653,
+	652,
+	651,
+	650,
+	649,
+	112,
+	648,
+	647,
+	646,
+	188,
+	187,
+	645,
+	341,
+	186,
+	644,
+	643,
+	642,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	185,
+	635,
+	634,
+	633,
+	632,
+	631,
+	630,
+	629,
+	628,
+	627,
+	111,
+	340,
+	339,
+	338,
+	337,
+	626,
+	625,
+	624,
+	623,
+	622,
+	621,
+	620,
+	619,
+	618,
+	617,
+	616,
+	615,
+	614,
+	110,
+	109,
+	613,
+	612,
+	611,
+	336,
+	610,
+	609,
+	608,
+	607,
+	606,
+	605,
+	604,
+	335,
+	334,
+	333,
+	332,
+	331,
+	71,
+	603,
+	602,
+	601,
+	600,
+	88,
+	599,
+	598,
+	597,
+	596,
+	595,
+	594,
+	184,
+	183,
+	182,
+	593,
+	592,
+	591,
+	590,
+	589,
+	588,
+	587,
+	586,
+	585,
+	108,
+	584,
+	583,
+	582,
+	581,
+	580,
+	579,
+	578,
+	577,
+	330,
+	329,
+	328,
+	327,
+	87,
+	576,
+	575,
+	574,
+	573,
+	107,
+	572,
+	571,
+	570,
+	569,
+	568,
+	567,
+	326,
+	325,
+	324,
+}
+
+// Profile is the pre-built german language profile.
+var Profile = langdet.Language{
+	Name:    "german",
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse("de"),
+	Scripts: []langdet.Script{langdet.ScriptLatin},
+}
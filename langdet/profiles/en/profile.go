@@ -0,0 +1,3140 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/english.txt; DO NOT EDIT.
+
+// Package en provides the pre-built english langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package en
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+	"B",
+	"By",
+	"By_",
+	"By__",
+	"By___",
+	"G",
+	"Go",
+	"Goo",
+	"Good",
+	"Good_",
+	"L",
+	"La",
+	"Lan",
+	"Lang",
+	"Langu",
+	"T",
+	"Th",
+	"The",
+	"The_",
+	"The__",
+	"_B",
+	"_By",
+	"_By_",
+	"_By__",
+	"_G",
+	"_Go",
+	"_Goo",
+	"_Good",
+	"_L",
+	"_La",
+	"_Lan",
+	"_Lang",
+	"_T",
+	"_Th",
+	"_The",
+	"_The_",
+	"__B",
+	"__By",
+	"__By_",
+	"__G",
+	"__Go",
+	"__Goo",
+	"__L",
+	"__La",
+	"__Lan",
+	"__T",
+	"__Th",
+	"__The",
+	"___B",
+	"___By",
+	"___G",
+	"___Go",
+	"___L",
+	"___La",
+	"___T",
+	"___Th",
+	"____B",
+	"____G",
+	"____L",
+	"____T",
+	"____a",
+	"____b",
+	"____c",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____i",
+	"____k",
+	"____l",
+	"____m",
+	"____n",
+	"____o",
+	"____p",
+	"____r",
+	"____s",
+	"____t",
+	"____v",
+	"____w",
+	"___a",
+	"___a_",
+	"___ag",
+	"___an",
+	"___b",
+	"___bo",
+	"___bu",
+	"___c",
+	"___ca",
+	"___co",
+	"___d",
+	"___de",
+	"___e",
+	"___en",
+	"___ev",
+	"___f",
+	"___fi",
+	"___fo",
+	"___fr",
+	"___g",
+	"___gi",
+	"___gr",
+	"___i",
+	"___id",
+	"___in",
+	"___it",
+	"___k",
+	"___kn",
+	"___l",
+	"___la",
+	"___le",
+	"___m",
+	"___ma",
+	"___mo",
+	"___n",
+	"___n_",
+	"___ne",
+	"___o",
+	"___of",
+	"___on",
+	"___or",
+	"___p",
+	"___pa",
+	"___pr",
+	"___r",
+	"___ra",
+	"___re",
+	"___s",
+	"___sa",
+	"___se",
+	"___sh",
+	"___si",
+	"___sn",
+	"___st",
+	"___t",
+	"___te",
+	"___th",
+	"___to",
+	"___ty",
+	"___v",
+	"___ve",
+	"___w",
+	"___we",
+	"___wh",
+	"___wi",
+	"___wo",
+	"___wr",
+	"__a",
+	"__a_",
+	"__a__",
+	"__ag",
+	"__aga",
+	"__an",
+	"__ana",
+	"__and",
+	"__any",
+	"__b",
+	"__bo",
+	"__boo",
+	"__box",
+	"__bu",
+	"__bui",
+	"__c",
+	"__ca",
+	"__cal",
+	"__can",
+	"__co",
+	"__com",
+	"__con",
+	"__d",
+	"__de",
+	"__det",
+	"__e",
+	"__en",
+	"__eno",
+	"__ev",
+	"__eve",
+	"__f",
+	"__fi",
+	"__fin",
+	"__fo",
+	"__for",
+	"__fr",
+	"__fre",
+	"__fro",
+	"__g",
+	"__gi",
+	"__giv",
+	"__gr",
+	"__gra",
+	"__i",
+	"__id",
+	"__ide",
+	"__in",
+	"__in_",
+	"__int",
+	"__it",
+	"__its",
+	"__k",
+	"__kn",
+	"__kno",
+	"__l",
+	"__la",
+	"__lan",
+	"__lar",
+	"__lat",
+	"__le",
+	"__lea",
+	"__let",
+	"__m",
+	"__ma",
+	"__man",
+	"__mat",
+	"__mo",
+	"__mos",
+	"__n",
+	"__n_",
+	"__n__",
+	"__ne",
+	"__new",
+	"__o",
+	"__of",
+	"__of_",
+	"__on",
+	"__on_",
+	"__or",
+	"__or_",
+	"__p",
+	"__pa",
+	"__pag",
+	"__pas",
+	"__pat",
+	"__pr",
+	"__pro",
+	"__r",
+	"__ra",
+	"__ran",
+	"__re",
+	"__rea",
+	"__rel",
+	"__rep",
+	"__s",
+	"__sa",
+	"__sam",
+	"__se",
+	"__sea",
+	"__sen",
+	"__seq",
+	"__sh",
+	"__sho",
+	"__si",
+	"__sin",
+	"__sn",
+	"__sni",
+	"__st",
+	"__sta",
+	"__t",
+	"__te",
+	"__ten",
+	"__tex",
+	"__th",
+	"__tha",
+	"__the",
+	"__to",
+	"__to_",
+	"__ty",
+	"__typ",
+	"__v",
+	"__ve",
+	"__ver",
+	"__w",
+	"__we",
+	"__web",
+	"__wh",
+	"__whe",
+	"__whi",
+	"__wi",
+	"__wit",
+	"__wo",
+	"__wor",
+	"__wr",
+	"__wri",
+	"_a",
+	"_a_",
+	"_a__",
+	"_a___",
+	"_ag",
+	"_aga",
+	"_agai",
+	"_an",
+	"_ana",
+	"_anal",
+	"_and",
+	"_and_",
+	"_any",
+	"_any_",
+	"_b",
+	"_bo",
+	"_boo",
+	"_book",
+	"_box",
+	"_box_",
+	"_bu",
+	"_bui",
+	"_buil",
+	"_c",
+	"_ca",
+	"_cal",
+	"_call",
+	"_can",
+	"_can_",
+	"_co",
+	"_com",
+	"_come",
+	"_comm",
+	"_comp",
+	"_con",
+	"_conf",
+	"_d",
+	"_de",
+	"_det",
+	"_dete",
+	"_e",
+	"_en",
+	"_eno",
+	"_enou",
+	"_ev",
+	"_eve",
+	"_even",
+	"_ever",
+	"_f",
+	"_fi",
+	"_fin",
+	"_fing",
+	"_fo",
+	"_for",
+	"_for_",
+	"_fr",
+	"_fre",
+	"_freq",
+	"_fro",
+	"_from",
+	"_g",
+	"_gi",
+	"_giv",
+	"_give",
+	"_gr",
+	"_gra",
+	"_gram",
+	"_i",
+	"_id",
+	"_ide",
+	"_idea",
+	"_in",
+	"_in_",
+	"_in__",
+	"_int",
+	"_into",
+	"_it",
+	"_its",
+	"_its_",
+	"_k",
+	"_kn",
+	"_kno",
+	"_know",
+	"_l",
+	"_la",
+	"_lan",
+	"_lang",
+	"_lar",
+	"_larg",
+	"_lat",
+	"_late",
+	"_le",
+	"_lea",
+	"_leas",
+	"_let",
+	"_lett",
+	"_m",
+	"_ma",
+	"_man",
+	"_many",
+	"_mat",
+	"_matc",
+	"_mo",
+	"_mos",
+	"_most",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_ne",
+	"_new",
+	"_new_",
+	"_o",
+	"_of",
+	"_of_",
+	"_of__",
+	"_on",
+	"_on_",
+	"_on__",
+	"_or",
+	"_or_",
+	"_or__",
+	"_p",
+	"_pa",
+	"_pag",
+	"_page",
+	"_pas",
+	"_pass",
+	"_pat",
+	"_patt",
+	"_pr",
+	"_pro",
+	"_prof",
+	"_r",
+	"_ra",
+	"_ran",
+	"_rank",
+	"_re",
+	"_rea",
+	"_reas",
+	"_rel",
+	"_rely",
+	"_rep",
+	"_repe",
+	"_s",
+	"_sa",
+	"_sam",
+	"_same",
+	"_samp",
+	"_se",
+	"_sea",
+	"_sear",
+	"_sen",
+	"_sent",
+	"_seq",
+	"_sequ",
+	"_sh",
+	"_sho",
+	"_shor",
+	"_si",
+	"_sin",
+	"_sing",
+	"_sn",
+	"_sni",
+	"_snip",
+	"_st",
+	"_sta",
+	"_stab",
+	"_t",
+	"_te",
+	"_ten",
+	"_tend",
+	"_tex",
+	"_text",
+	"_th",
+	"_tha",
+	"_that",
+	"_the",
+	"_the_",
+	"_thes",
+	"_to",
+	"_to_",
+	"_to__",
+	"_ty",
+	"_typ",
+	"_type",
+	"_v",
+	"_ve",
+	"_ver",
+	"_very",
+	"_w",
+	"_we",
+	"_web",
+	"_web_",
+	"_wh",
+	"_whe",
+	"_whet",
+	"_whi",
+	"_whic",
+	"_wi",
+	"_wit",
+	"_with",
+	"_wo",
+	"_wor",
+	"_work",
+	"_wr",
+	"_wri",
+	"_writ",
+	"a",
+	"a_",
+	"a__",
+	"a___",
+	"a____",
+	"ab",
+	"abl",
+	"able",
+	"able_",
+	"ac",
+	"act",
+	"act_",
+	"act__",
+	"ag",
+	"aga",
+	"agai",
+	"again",
+	"age",
+	"age_",
+	"age__",
+	"ages",
+	"ages_",
+	"ai",
+	"ain",
+	"ains",
+	"ainst",
+	"al",
+	"all",
+	"alle",
+	"alled",
+	"aly",
+	"alyz",
+	"alyze",
+	"am",
+	"ame",
+	"ame_",
+	"ame__",
+	"amp",
+	"ampl",
+	"ample",
+	"ams",
+	"ams_",
+	"ams__",
+	"an",
+	"an_",
+	"an__",
+	"an___",
+	"ana",
+	"anal",
+	"analy",
+	"and",
+	"and_",
+	"and__",
+	"ang",
+	"angu",
+	"angua",
+	"ank",
+	"anki",
+	"ankin",
+	"any",
+	"any_",
+	"any__",
+	"ar",
+	"arc",
+	"arch",
+	"arch_",
+	"are",
+	"ares",
+	"ares_",
+	"arg",
+	"arge",
+	"arge_",
+	"as",
+	"aso",
+	"ason",
+	"asona",
+	"ass",
+	"assa",
+	"assag",
+	"ast",
+	"ast_",
+	"ast__",
+	"at",
+	"at_",
+	"at__",
+	"at___",
+	"atc",
+	"atch",
+	"atch_",
+	"ate",
+	"ater",
+	"ater_",
+	"att",
+	"atte",
+	"atter",
+	"ay",
+	"ay_",
+	"ay__",
+	"ay___",
+	"b",
+	"b_",
+	"b__",
+	"b___",
+	"b____",
+	"bl",
+	"ble",
+	"ble_",
+	"ble__",
+	"bo",
+	"boo",
+	"book",
+	"book_",
+	"box",
+	"box_",
+	"box__",
+	"bu",
+	"bui",
+	"buil",
+	"build",
+	"c",
+	"ca",
+	"cal",
+	"call",
+	"calle",
+	"can",
+	"can_",
+	"can__",
+	"ce",
+	"ce_",
+	"ce__",
+	"ce___",
+	"ces",
+	"ces_",
+	"ces__",
+	"ch",
+	"ch_",
+	"ch__",
+	"ch___",
+	"co",
+	"com",
+	"come",
+	"comes",
+	"comm",
+	"commo",
+	"comp",
+	"compa",
+	"con",
+	"conf",
+	"confi",
+	"ct",
+	"ct_",
+	"ct__",
+	"ct___",
+	"cti",
+	"ctio",
+	"ction",
+	"cto",
+	"ctor",
+	"ctor_",
+	"ctors",
+	"cy",
+	"cy_",
+	"cy__",
+	"cy___",
+	"d",
+	"d_",
+	"d__",
+	"d___",
+	"d____",
+	"da",
+	"day",
+	"day_",
+	"day__",
+	"de",
+	"dea",
+	"dea_",
+	"dea__",
+	"den",
+	"denc",
+	"dence",
+	"det",
+	"dete",
+	"detec",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"ea",
+	"ea_",
+	"ea__",
+	"ea___",
+	"ear",
+	"earc",
+	"earch",
+	"eas",
+	"easo",
+	"eason",
+	"east",
+	"east_",
+	"eat",
+	"eat_",
+	"eat__",
+	"eb",
+	"eb_",
+	"eb__",
+	"eb___",
+	"ec",
+	"ect",
+	"ecti",
+	"ectio",
+	"ecto",
+	"ector",
+	"ed",
+	"ed_",
+	"ed__",
+	"ed___",
+	"el",
+	"ely",
+	"ely_",
+	"ely__",
+	"en",
+	"en_",
+	"en__",
+	"en___",
+	"enc",
+	"ence",
+	"ence_",
+	"ences",
+	"ency",
+	"ency_",
+	"end",
+	"end_",
+	"end__",
+	"eno",
+	"enou",
+	"enoug",
+	"ent",
+	"ent_",
+	"ent__",
+	"ente",
+	"enten",
+	"ep",
+	"epe",
+	"epea",
+	"epeat",
+	"eq",
+	"equ",
+	"eque",
+	"equen",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"ern",
+	"erns",
+	"erns_",
+	"erp",
+	"erpr",
+	"erpri",
+	"ers",
+	"ers_",
+	"ers__",
+	"ery",
+	"ery_",
+	"ery__",
+	"eryd",
+	"eryda",
+	"es",
+	"es_",
+	"es__",
+	"es___",
+	"ese",
+	"ese_",
+	"ese__",
+	"et",
+	"ete",
+	"etec",
+	"etect",
+	"eth",
+	"ethe",
+	"ether",
+	"ets",
+	"ets_",
+	"ets__",
+	"ett",
+	"ette",
+	"etter",
+	"ev",
+	"eve",
+	"even",
+	"even_",
+	"ever",
+	"every",
+	"ew",
+	"ew_",
+	"ew__",
+	"ew___",
+	"ex",
+	"ext",
+	"ext_",
+	"ext__",
+	"f",
+	"f_",
+	"f__",
+	"f___",
+	"f____",
+	"fi",
+	"fid",
+	"fide",
+	"fiden",
+	"fil",
+	"file",
+	"files",
+	"fin",
+	"fing",
+	"finge",
+	"fo",
+	"for",
+	"for_",
+	"for__",
+	"fr",
+	"fre",
+	"freq",
+	"frequ",
+	"fro",
+	"from",
+	"from_",
+	"g",
+	"g_",
+	"g__",
+	"g___",
+	"g____",
+	"ga",
+	"gai",
+	"gain",
+	"gains",
+	"ge",
+	"ge_",
+	"ge__",
+	"ge___",
+	"ger",
+	"gerp",
+	"gerpr",
+	"ges",
+	"ges_",
+	"ges__",
+	"gh",
+	"gh_",
+	"gh__",
+	"gh___",
+	"gi",
+	"giv",
+	"give",
+	"given",
+	"gl",
+	"gle",
+	"gle_",
+	"gle__",
+	"gr",
+	"gra",
+	"gram",
+	"grams",
+	"gu",
+	"gua",
+	"guag",
+	"guage",
+	"h",
+	"h_",
+	"h__",
+	"h___",
+	"h____",
+	"ha",
+	"hat",
+	"hat_",
+	"hat__",
+	"he",
+	"he_",
+	"he__",
+	"he___",
+	"her",
+	"her_",
+	"her__",
+	"hes",
+	"hese",
+	"hese_",
+	"het",
+	"heth",
+	"hethe",
+	"hi",
+	"hic",
+	"hich",
+	"hich_",
+	"ho",
+	"hor",
+	"hort",
+	"hort_",
+	"i",
+	"ic",
+	"ich",
+	"ich_",
+	"ich__",
+	"id",
+	"ide",
+	"idea",
+	"idea_",
+	"iden",
+	"idenc",
+	"il",
+	"ild",
+	"ild_",
+	"ild__",
+	"ile",
+	"iles",
+	"iles_",
+	"in",
+	"in_",
+	"in__",
+	"in___",
+	"ing",
+	"ing_",
+	"ing__",
+	"inge",
+	"inger",
+	"ingl",
+	"ingle",
+	"ins",
+	"inst",
+	"inst_",
+	"int",
+	"int_",
+	"int__",
+	"into",
+	"into_",
+	"io",
+	"ion",
+	"ion_",
+	"ion__",
+	"ip",
+	"ipp",
+	"ippe",
+	"ippet",
+	"it",
+	"ith",
+	"ith_",
+	"ith__",
+	"iti",
+	"itin",
+	"iting",
+	"its",
+	"its_",
+	"its__",
+	"iv",
+	"ive",
+	"iven",
+	"iven_",
+	"k",
+	"k_",
+	"k__",
+	"k___",
+	"k____",
+	"ki",
+	"kin",
+	"king",
+	"king_",
+	"kn",
+	"kno",
+	"know",
+	"known",
+	"ks",
+	"ks_",
+	"ks__",
+	"ks___",
+	"l",
+	"la",
+	"lan",
+	"lang",
+	"langu",
+	"lar",
+	"larg",
+	"large",
+	"lat",
+	"late",
+	"later",
+	"ld",
+	"ld_",
+	"ld__",
+	"ld___",
+	"le",
+	"le_",
+	"le__",
+	"le___",
+	"lea",
+	"leas",
+	"least",
+	"led",
+	"led_",
+	"led__",
+	"les",
+	"les_",
+	"les__",
+	"let",
+	"lett",
+	"lette",
+	"ll",
+	"lle",
+	"lled",
+	"lled_",
+	"ly",
+	"ly_",
+	"ly__",
+	"ly___",
+	"lyz",
+	"lyze",
+	"lyzes",
+	"m",
+	"m_",
+	"m__",
+	"m___",
+	"m____",
+	"ma",
+	"man",
+	"many",
+	"many_",
+	"mat",
+	"matc",
+	"match",
+	"me",
+	"me_",
+	"me__",
+	"me___",
+	"mes",
+	"mes_",
+	"mes__",
+	"mm",
+	"mmo",
+	"mmon",
+	"mmon_",
+	"mo",
+	"mon",
+	"mon_",
+	"mon__",
+	"mos",
+	"most",
+	"most_",
+	"mp",
+	"mpa",
+	"mpac",
+	"mpact",
+	"mpar",
+	"mpare",
+	"mpl",
+	"mple",
+	"mple_",
+	"ms",
+	"ms_",
+	"ms__",
+	"ms___",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"nab",
+	"nabl",
+	"nable",
+	"nal",
+	"naly",
+	"nalyz",
+	"nc",
+	"nce",
+	"nce_",
+	"nce__",
+	"nces",
+	"nces_",
+	"ncy",
+	"ncy_",
+	"ncy__",
+	"nd",
+	"nd_",
+	"nd__",
+	"nd___",
+	"ne",
+	"new",
+	"new_",
+	"new__",
+	"nf",
+	"nfi",
+	"nfid",
+	"nfide",
+	"ng",
+	"ng_",
+	"ng__",
+	"ng___",
+	"nge",
+	"nger",
+	"ngerp",
+	"ngl",
+	"ngle",
+	"ngle_",
+	"ngu",
+	"ngua",
+	"nguag",
+	"ni",
+	"nip",
+	"nipp",
+	"nippe",
+	"nk",
+	"nki",
+	"nkin",
+	"nking",
+	"no",
+	"nou",
+	"noug",
+	"nough",
+	"now",
+	"nown",
+	"nown_",
+	"ns",
+	"ns_",
+	"ns__",
+	"ns___",
+	"nst",
+	"nst_",
+	"nst__",
+	"nt",
+	"nt_",
+	"nt__",
+	"nt___",
+	"nte",
+	"nten",
+	"ntenc",
+	"nto",
+	"nto_",
+	"nto__",
+	"ny",
+	"ny_",
+	"ny__",
+	"ny___",
+	"o",
+	"o_",
+	"o__",
+	"o___",
+	"o____",
+	"od",
+	"od_",
+	"od__",
+	"od___",
+	"of",
+	"of_",
+	"of__",
+	"of___",
+	"ofi",
+	"ofil",
+	"ofile",
+	"ok",
+	"ok_",
+	"ok__",
+	"ok___",
+	"om",
+	"om_",
+	"om__",
+	"om___",
+	"ome",
+	"omes",
+	"omes_",
+	"omm",
+	"ommo",
+	"ommon",
+	"omp",
+	"ompa",
+	"ompac",
+	"ompar",
+	"on",
+	"on_",
+	"on__",
+	"on___",
+	"ona",
+	"onab",
+	"onabl",
+	"onf",
+	"onfi",
+	"onfid",
+	"oo",
+	"ood",
+	"ood_",
+	"ood__",
+	"ook",
+	"ook_",
+	"ook__",
+	"or",
+	"or_",
+	"or__",
+	"or___",
+	"ork",
+	"orks",
+	"orks_",
+	"ors",
+	"ors_",
+	"ors__",
+	"ort",
+	"ort_",
+	"ort__",
+	"os",
+	"ost",
+	"ost_",
+	"ost__",
+	"ou",
+	"oug",
+	"ough",
+	"ough_",
+	"ow",
+	"own",
+	"own_",
+	"own__",
+	"ox",
+	"ox_",
+	"ox__",
+	"ox___",
+	"p",
+	"pa",
+	"pac",
+	"pact",
+	"pact_",
+	"pag",
+	"page",
+	"page_",
+	"par",
+	"pare",
+	"pares",
+	"pas",
+	"pass",
+	"passa",
+	"pat",
+	"patt",
+	"patte",
+	"pe",
+	"pea",
+	"peat",
+	"peat_",
+	"ped",
+	"ped_",
+	"ped__",
+	"pet",
+	"pets",
+	"pets_",
+	"pl",
+	"ple",
+	"ple_",
+	"ple__",
+	"pp",
+	"ppe",
+	"ppet",
+	"ppets",
+	"pr",
+	"pri",
+	"prin",
+	"print",
+	"pro",
+	"prof",
+	"profi",
+	"q",
+	"qu",
+	"que",
+	"quen",
+	"quenc",
+	"quent",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"ram",
+	"rams",
+	"rams_",
+	"ran",
+	"rank",
+	"ranki",
+	"rc",
+	"rch",
+	"rch_",
+	"rch__",
+	"re",
+	"rea",
+	"reas",
+	"reaso",
+	"rel",
+	"rely",
+	"rely_",
+	"rep",
+	"repe",
+	"repea",
+	"req",
+	"requ",
+	"reque",
+	"res",
+	"res_",
+	"res__",
+	"rg",
+	"rge",
+	"rge_",
+	"rge__",
+	"ri",
+	"rin",
+	"rint",
+	"rint_",
+	"rit",
+	"riti",
+	"ritin",
+	"rk",
+	"rks",
+	"rks_",
+	"rks__",
+	"rn",
+	"rns",
+	"rns_",
+	"rns__",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"rom",
+	"rom_",
+	"rom__",
+	"rp",
+	"rpr",
+	"rpri",
+	"rprin",
+	"rs",
+	"rs_",
+	"rs__",
+	"rs___",
+	"rt",
+	"rt_",
+	"rt__",
+	"rt___",
+	"ry",
+	"ry_",
+	"ry__",
+	"ry___",
+	"ryd",
+	"ryda",
+	"ryday",
+	"s",
+	"s_",
+	"s__",
+	"s___",
+	"s____",
+	"sa",
+	"sag",
+	"sage",
+	"sage_",
+	"sam",
+	"same",
+	"same_",
+	"samp",
+	"sampl",
+	"se",
+	"se_",
+	"se__",
+	"se___",
+	"sea",
+	"sear",
+	"searc",
+	"sen",
+	"sent",
+	"sente",
+	"seq",
+	"sequ",
+	"seque",
+	"sh",
+	"sho",
+	"shor",
+	"short",
+	"si",
+	"sin",
+	"sing",
+	"singl",
+	"sn",
+	"sni",
+	"snip",
+	"snipp",
+	"so",
+	"son",
+	"sona",
+	"sonab",
+	"ss",
+	"ssa",
+	"ssag",
+	"ssage",
+	"st",
+	"st_",
+	"st__",
+	"st___",
+	"sta",
+	"stab",
+	"stabl",
+	"t",
+	"t_",
+	"t__",
+	"t___",
+	"t____",
+	"ta",
+	"tab",
+	"tabl",
+	"table",
+	"tc",
+	"tch",
+	"tch_",
+	"tch__",
+	"te",
+	"tec",
+	"tect",
+	"tecti",
+	"tecto",
+	"ten",
+	"tenc",
+	"tence",
+	"tend",
+	"tend_",
+	"ter",
+	"ter_",
+	"ter__",
+	"tern",
+	"terns",
+	"ters",
+	"ters_",
+	"tex",
+	"text",
+	"text_",
+	"th",
+	"th_",
+	"th__",
+	"th___",
+	"tha",
+	"that",
+	"that_",
+	"the",
+	"the_",
+	"the__",
+	"ther",
+	"ther_",
+	"thes",
+	"these",
+	"ti",
+	"tin",
+	"ting",
+	"ting_",
+	"tio",
+	"tion",
+	"tion_",
+	"to",
+	"to_",
+	"to__",
+	"to___",
+	"tor",
+	"tor_",
+	"tor__",
+	"tors",
+	"tors_",
+	"ts",
+	"ts_",
+	"ts__",
+	"ts___",
+	"tt",
+	"tte",
+	"tter",
+	"tter_",
+	"ttern",
+	"tters",
+	"ty",
+	"typ",
+	"type",
+	"typed",
+	"u",
+	"ua",
+	"uag",
+	"uage",
+	"uage_",
+	"uages",
+	"ue",
+	"uen",
+	"uenc",
+	"uence",
+	"uency",
+	"uent",
+	"uent_",
+	"ug",
+	"ugh",
+	"ugh_",
+	"ugh__",
+	"ui",
+	"uil",
+	"uild",
+	"uild_",
+	"v",
+	"ve",
+	"ven",
+	"ven_",
+	"ven__",
+	"ver",
+	"very",
+	"very_",
+	"veryd",
+	"w",
+	"w_",
+	"w__",
+	"w___",
+	"w____",
+	"we",
+	"web",
+	"web_",
+	"web__",
+	"wh",
+	"whe",
+	"whet",
+	"wheth",
+	"whi",
+	"whic",
+	"which",
+	"wi",
+	"wit",
+	"with",
+	"with_",
+	"wn",
+	"wn_",
+	"wn__",
+	"wn___",
+	"wo",
+	"wor",
+	"work",
+	"works",
+	"wr",
+	"wri",
+	"writ",
+	"writi",
+	"x",
+	"x_",
+	"x__",
+	"x___",
+	"x____",
+	"xt",
+	"xt_",
+	"xt__",
+	"xt___",
+	"y",
+	"y_",
+	"y__",
+	"y___",
+	"y____",
+	"yd",
+	"yda",
+	"yday",
+	"yday_",
+	"yp",
+	"ype",
+	"yped",
+	"yped_",
+	"yz",
+	"yze",
+	"yzes",
+	"yzes_",
+	"z",
+	"ze",
+	"zes",
+	"zes_",
+	"zes__",
+}
+
+var ranks = []uint16{
+	1558,
+	1557,
+	1556,
+	1555,
+	1554,
+	1553,
+	1552,
+	1551,
+	1550,
+	1549,
+	1548,
+	1547,
+	1546,
+	1545,
+	1544,
+	1543,
+	1542,
+	1541,
+	1540,
+	1539,
+	1538,
+	1537,
+	1536,
+	1535,
+	1534,
+	1533,
+	1532,
+	1531,
+	1530,
+	1529,
+	1528,
+	1527,
+	1526,
+	1525,
+	1524,
+	1523,
+	1522,
+	1521,
+	1520,
+	1519,
+	1518,
+	1517,
+	1516,
+	1515,
+	1514,
+	1513,
+	1512,
+	1511,
+	1510,
+	1509,
+	1508,
+	1507,
+	1506,
+	1505,
+	1504,
+	1503,
+	1502,
+	1501,
+	1500,
+	1499,
+	24,
+	358,
+	101,
+	357,
+	356,
+	75,
+	355,
+	209,
+	1498,
+	74,
+	354,
+	353,
+	100,
+	208,
+	207,
+	43,
+	59,
+	1497,
+	99,
+	23,
+	58,
+	633,
+	206,
+	352,
+	632,
+	1496,
+	98,
+	631,
+	150,
+	351,
+	350,
+	349,
+	1495,
+	630,
+	73,
+	629,
+	628,
+	205,
+	348,
+	1494,
+	627,
+	204,
+	1493,
+	626,
+	1492,
+	1491,
+	1490,
+	72,
+	149,
+	347,
+	346,
+	625,
+	1489,
+	345,
+	624,
+	1488,
+	97,
+	148,
+	1487,
+	1486,
+	203,
+	344,
+	1485,
+	202,
+	1484,
+	343,
+	42,
+	623,
+	342,
+	341,
+	1483,
+	1482,
+	1481,
+	57,
+	201,
+	340,
+	622,
+	1480,
+	1479,
+	1478,
+	96,
+	1477,
+	621,
+	620,
+	1476,
+	1475,
+	22,
+	56,
+	55,
+	619,
+	618,
+	200,
+	1474,
+	617,
+	1473,
+	339,
+	616,
+	1472,
+	1471,
+	1470,
+	1469,
+	95,
+	615,
+	1468,
+	1467,
+	147,
+	199,
+	1466,
+	338,
+	337,
+	336,
+	335,
+	1465,
+	1464,
+	614,
+	613,
+	71,
+	612,
+	611,
+	610,
+	609,
+	198,
+	608,
+	607,
+	334,
+	1463,
+	1462,
+	606,
+	605,
+	197,
+	1461,
+	1460,
+	604,
+	1459,
+	1458,
+	1457,
+	1456,
+	1455,
+	1454,
+	1453,
+	70,
+	146,
+	333,
+	1452,
+	1451,
+	332,
+	1450,
+	603,
+	331,
+	602,
+	1449,
+	1448,
+	1447,
+	1446,
+	330,
+	601,
+	600,
+	1445,
+	1444,
+	94,
+	145,
+	144,
+	1443,
+	1442,
+	1441,
+	1440,
+	196,
+	329,
+	1439,
+	1438,
+	1437,
+	1436,
+	1435,
+	195,
+	1434,
+	1433,
+	328,
+	1432,
+	1431,
+	1430,
+	41,
+	599,
+	598,
+	327,
+	1429,
+	1428,
+	1427,
+	326,
+	325,
+	1426,
+	1425,
+	1424,
+	1423,
+	1422,
+	1421,
+	54,
+	194,
+	1420,
+	324,
+	323,
+	1419,
+	597,
+	596,
+	595,
+	1418,
+	1417,
+	1416,
+	1415,
+	1414,
+	93,
+	1413,
+	1412,
+	594,
+	1411,
+	1410,
+	593,
+	592,
+	1409,
+	1408,
+	1407,
+	1406,
+	21,
+	53,
+	52,
+	51,
+	591,
+	590,
+	589,
+	193,
+	1405,
+	1404,
+	588,
+	587,
+	1403,
+	1402,
+	322,
+	586,
+	1401,
+	1400,
+	1399,
+	1398,
+	1397,
+	1396,
+	1395,
+	92,
+	585,
+	1394,
+	1393,
+	1392,
+	1391,
+	143,
+	192,
+	1390,
+	1389,
+	584,
+	1388,
+	1387,
+	321,
+	320,
+	319,
+	318,
+	317,
+	1386,
+	1385,
+	1384,
+	583,
+	582,
+	1383,
+	1382,
+	69,
+	581,
+	580,
+	579,
+	578,
+	577,
+	576,
+	191,
+	575,
+	574,
+	573,
+	572,
+	316,
+	1381,
+	1380,
+	1379,
+	571,
+	570,
+	569,
+	190,
+	1378,
+	1377,
+	1376,
+	568,
+	1375,
+	1374,
+	1373,
+	1372,
+	1371,
+	1370,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	68,
+	142,
+	315,
+	314,
+	1364,
+	1363,
+	1362,
+	1361,
+	313,
+	1360,
+	1359,
+	567,
+	566,
+	312,
+	565,
+	1358,
+	1357,
+	1356,
+	1355,
+	1354,
+	1353,
+	1352,
+	311,
+	564,
+	563,
+	562,
+	1351,
+	1350,
+	1349,
+	91,
+	141,
+	140,
+	139,
+	1348,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	189,
+	310,
+	1342,
+	1341,
+	1340,
+	1339,
+	1338,
+	1337,
+	1336,
+	1335,
+	1334,
+	188,
+	1333,
+	1332,
+	1331,
+	309,
+	1330,
+	1329,
+	1328,
+	1327,
+	1326,
+	1325,
+	40,
+	561,
+	560,
+	1324,
+	1323,
+	308,
+	1322,
+	1321,
+	1320,
+	1319,
+	1318,
+	1317,
+	307,
+	306,
+	305,
+	1316,
+	1315,
+	1314,
+	1313,
+	1312,
+	1311,
+	1310,
+	1309,
+	1308,
+	50,
+	187,
+	1307,
+	1306,
+	304,
+	303,
+	302,
+	1305,
+	1304,
+	559,
+	1303,
+	1302,
+	558,
+	557,
+	556,
+	1301,
+	1300,
+	1299,
+	1298,
+	1297,
+	1296,
+	1295,
+	90,
+	1294,
+	1293,
+	1292,
+	555,
+	1291,
+	1290,
+	1289,
+	1288,
+	554,
+	553,
+	552,
+	1287,
+	1286,
+	1285,
+	1284,
+	1283,
+	1282,
+	2,
+	39,
+	38,
+	37,
+	36,
+	551,
+	550,
+	549,
+	548,
+	1281,
+	1280,
+	1279,
+	1278,
+	67,
+	547,
+	546,
+	545,
+	117,
+	138,
+	137,
+	1277,
+	1276,
+	544,
+	543,
+	542,
+	541,
+	540,
+	1275,
+	1274,
+	1273,
+	1272,
+	1271,
+	1270,
+	186,
+	1269,
+	1268,
+	1267,
+	1266,
+	1265,
+	1264,
+	539,
+	538,
+	537,
+	35,
+	1263,
+	1262,
+	1261,
+	1260,
+	1259,
+	1258,
+	536,
+	535,
+	534,
+	185,
+	184,
+	183,
+	1257,
+	1256,
+	1255,
+	533,
+	532,
+	531,
+	301,
+	1254,
+	1253,
+	1252,
+	1251,
+	1250,
+	1249,
+	1248,
+	1247,
+	1246,
+	300,
+	1245,
+	1244,
+	1243,
+	1242,
+	1241,
+	1240,
+	1239,
+	1238,
+	1237,
+	136,
+	530,
+	529,
+	528,
+	1236,
+	1235,
+	1234,
+	1233,
+	1232,
+	1231,
+	1230,
+	1229,
+	1228,
+	1227,
+	1226,
+	1225,
+	1224,
+	116,
+	1223,
+	1222,
+	1221,
+	1220,
+	527,
+	526,
+	525,
+	524,
+	523,
+	1219,
+	1218,
+	1217,
+	1216,
+	1215,
+	1214,
+	1213,
+	1212,
+	1211,
+	1210,
+	11,
+	522,
+	1209,
+	1208,
+	1207,
+	1206,
+	1205,
+	1204,
+	299,
+	521,
+	520,
+	519,
+	1203,
+	1202,
+	1201,
+	298,
+	297,
+	296,
+	295,
+	135,
+	182,
+	1200,
+	1199,
+	1198,
+	1197,
+	518,
+	517,
+	1196,
+	1195,
+	1194,
+	181,
+	1193,
+	1192,
+	1191,
+	1190,
+	1189,
+	1188,
+	516,
+	515,
+	1187,
+	1186,
+	1185,
+	1184,
+	1183,
+	1182,
+	32,
+	89,
+	88,
+	87,
+	86,
+	1181,
+	1180,
+	1179,
+	1178,
+	134,
+	1177,
+	1176,
+	1175,
+	1174,
+	1173,
+	1172,
+	294,
+	293,
+	292,
+	1,
+	20,
+	19,
+	18,
+	17,
+	133,
+	1171,
+	1170,
+	1169,
+	1168,
+	1167,
+	1166,
+	514,
+	1165,
+	1164,
+	1163,
+	1162,
+	1161,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	1155,
+	291,
+	290,
+	1154,
+	1153,
+	513,
+	512,
+	511,
+	510,
+	509,
+	508,
+	1152,
+	1151,
+	1150,
+	1149,
+	49,
+	507,
+	506,
+	505,
+	180,
+	289,
+	504,
+	1148,
+	1147,
+	1146,
+	1145,
+	1144,
+	1143,
+	1142,
+	1141,
+	1140,
+	503,
+	1139,
+	1138,
+	1137,
+	1136,
+	1135,
+	1134,
+	1133,
+	1132,
+	288,
+	287,
+	286,
+	285,
+	66,
+	284,
+	283,
+	282,
+	1131,
+	1130,
+	1129,
+	502,
+	501,
+	500,
+	1128,
+	1127,
+	1126,
+	499,
+	1125,
+	1124,
+	1123,
+	1122,
+	85,
+	115,
+	114,
+	113,
+	1121,
+	1120,
+	1119,
+	84,
+	281,
+	280,
+	279,
+	1118,
+	1117,
+	1116,
+	1115,
+	1114,
+	1113,
+	498,
+	497,
+	496,
+	495,
+	494,
+	1112,
+	1111,
+	1110,
+	1109,
+	1108,
+	1107,
+	1106,
+	1105,
+	278,
+	277,
+	276,
+	275,
+	25,
+	132,
+	131,
+	130,
+	129,
+	179,
+	1104,
+	1103,
+	1102,
+	1101,
+	1100,
+	1099,
+	493,
+	492,
+	491,
+	490,
+	489,
+	488,
+	487,
+	178,
+	486,
+	485,
+	484,
+	483,
+	482,
+	481,
+	9,
+	480,
+	479,
+	478,
+	477,
+	476,
+	475,
+	474,
+	473,
+	65,
+	112,
+	111,
+	110,
+	472,
+	471,
+	470,
+	1098,
+	1097,
+	1096,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	1088,
+	1087,
+	1086,
+	1085,
+	1084,
+	469,
+	468,
+	467,
+	466,
+	177,
+	176,
+	175,
+	174,
+	16,
+	109,
+	108,
+	107,
+	106,
+	1083,
+	1082,
+	1081,
+	1080,
+	128,
+	465,
+	464,
+	463,
+	1079,
+	1078,
+	1077,
+	1076,
+	1075,
+	1074,
+	1073,
+	1072,
+	1071,
+	1070,
+	1069,
+	1068,
+	1067,
+	274,
+	273,
+	272,
+	271,
+	8,
+	1066,
+	1065,
+	1064,
+	1063,
+	462,
+	461,
+	1062,
+	1061,
+	1060,
+	1059,
+	460,
+	1058,
+	1057,
+	1056,
+	1055,
+	1054,
+	1053,
+	34,
+	1052,
+	1051,
+	1050,
+	127,
+	459,
+	458,
+	457,
+	456,
+	1049,
+	1048,
+	455,
+	454,
+	453,
+	270,
+	452,
+	451,
+	1047,
+	1046,
+	1045,
+	1044,
+	1043,
+	1042,
+	1041,
+	1040,
+	1039,
+	1038,
+	173,
+	450,
+	449,
+	448,
+	1037,
+	1036,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	1030,
+	1029,
+	1028,
+	172,
+	1027,
+	1026,
+	1025,
+	1024,
+	1023,
+	1022,
+	1021,
+	1020,
+	1019,
+	1018,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	1012,
+	10,
+	126,
+	269,
+	268,
+	267,
+	1011,
+	1010,
+	1009,
+	1008,
+	1007,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	64,
+	171,
+	170,
+	169,
+	1001,
+	1000,
+	999,
+	998,
+	997,
+	996,
+	995,
+	994,
+	993,
+	447,
+	446,
+	445,
+	992,
+	991,
+	990,
+	989,
+	444,
+	988,
+	987,
+	986,
+	985,
+	984,
+	983,
+	30,
+	443,
+	442,
+	441,
+	440,
+	439,
+	982,
+	981,
+	980,
+	979,
+	978,
+	977,
+	438,
+	976,
+	975,
+	974,
+	973,
+	972,
+	971,
+	970,
+	969,
+	968,
+	967,
+	437,
+	966,
+	965,
+	964,
+	963,
+	962,
+	961,
+	266,
+	436,
+	960,
+	959,
+	958,
+	957,
+	956,
+	955,
+	954,
+	435,
+	434,
+	433,
+	432,
+	4,
+	48,
+	47,
+	46,
+	45,
+	431,
+	953,
+	952,
+	951,
+	950,
+	949,
+	948,
+	168,
+	265,
+	430,
+	429,
+	947,
+	946,
+	945,
+	944,
+	943,
+	264,
+	263,
+	262,
+	261,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	935,
+	63,
+	428,
+	427,
+	426,
+	425,
+	424,
+	423,
+	934,
+	933,
+	932,
+	167,
+	166,
+	165,
+	931,
+	930,
+	929,
+	928,
+	927,
+	926,
+	925,
+	924,
+	422,
+	923,
+	922,
+	921,
+	920,
+	919,
+	918,
+	260,
+	917,
+	916,
+	915,
+	421,
+	420,
+	419,
+	125,
+	259,
+	258,
+	257,
+	914,
+	913,
+	912,
+	911,
+	910,
+	909,
+	418,
+	417,
+	416,
+	415,
+	5,
+	256,
+	255,
+	254,
+	253,
+	908,
+	907,
+	906,
+	905,
+	105,
+	124,
+	123,
+	122,
+	904,
+	903,
+	902,
+	901,
+	900,
+	899,
+	898,
+	104,
+	414,
+	413,
+	412,
+	897,
+	896,
+	895,
+	894,
+	893,
+	892,
+	411,
+	410,
+	891,
+	890,
+	121,
+	252,
+	251,
+	250,
+	889,
+	888,
+	887,
+	886,
+	885,
+	884,
+	409,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	62,
+	164,
+	163,
+	162,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	249,
+	248,
+	247,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	862,
+	861,
+	860,
+	859,
+	858,
+	857,
+	856,
+	31,
+	120,
+	855,
+	854,
+	853,
+	852,
+	851,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	844,
+	843,
+	842,
+	841,
+	246,
+	840,
+	839,
+	838,
+	837,
+	836,
+	835,
+	834,
+	833,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	826,
+	825,
+	824,
+	245,
+	408,
+	407,
+	406,
+	823,
+	822,
+	821,
+	244,
+	243,
+	242,
+	241,
+	405,
+	820,
+	6,
+	83,
+	82,
+	81,
+	80,
+	240,
+	404,
+	403,
+	402,
+	819,
+	818,
+	817,
+	816,
+	815,
+	814,
+	813,
+	103,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	401,
+	400,
+	399,
+	803,
+	802,
+	801,
+	800,
+	799,
+	798,
+	797,
+	239,
+	398,
+	397,
+	396,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	786,
+	238,
+	785,
+	784,
+	783,
+	395,
+	394,
+	393,
+	392,
+	391,
+	390,
+	389,
+	388,
+	387,
+	386,
+	385,
+	237,
+	236,
+	235,
+	234,
+	384,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	7,
+	29,
+	28,
+	27,
+	26,
+	233,
+	776,
+	775,
+	774,
+	383,
+	773,
+	772,
+	771,
+	770,
+	161,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	232,
+	231,
+	230,
+	229,
+	757,
+	756,
+	755,
+	754,
+	753,
+	752,
+	751,
+	750,
+	749,
+	748,
+	747,
+	746,
+	745,
+	744,
+	743,
+	742,
+	119,
+	160,
+	159,
+	158,
+	741,
+	740,
+	739,
+	3,
+	15,
+	14,
+	13,
+	12,
+	738,
+	737,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	33,
+	228,
+	227,
+	730,
+	382,
+	381,
+	729,
+	728,
+	727,
+	726,
+	157,
+	380,
+	379,
+	725,
+	724,
+	723,
+	722,
+	226,
+	225,
+	224,
+	102,
+	378,
+	377,
+	376,
+	721,
+	720,
+	719,
+	223,
+	718,
+	717,
+	716,
+	715,
+	714,
+	713,
+	375,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	118,
+	222,
+	221,
+	220,
+	374,
+	706,
+	705,
+	704,
+	703,
+	373,
+	372,
+	371,
+	370,
+	219,
+	218,
+	217,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	696,
+	61,
+	156,
+	155,
+	154,
+	216,
+	695,
+	215,
+	214,
+	369,
+	694,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	686,
+	685,
+	684,
+	683,
+	153,
+	152,
+	368,
+	367,
+	366,
+	365,
+	364,
+	682,
+	681,
+	60,
+	680,
+	679,
+	678,
+	677,
+	676,
+	675,
+	674,
+	673,
+	363,
+	672,
+	671,
+	670,
+	669,
+	668,
+	667,
+	362,
+	361,
+	360,
+	359,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	151,
+	654,
+	653,
+	652,
+	651,
+	213,
+	212,
+	211,
+	210,
+	44,
+	79,
+	78,
+	77,
+	76,
+	650,
+	649,
+	648,
+	647,
+	646,
+	645,
+	644,
+	643,
+	642,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	635,
+	634,
+}
+
+// Profile is the pre-built english language profile.
+var Profile = langdet.Language{
+	Name:    "english",
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse("en"),
+	Scripts: []langdet.Script{langdet.ScriptLatin},
+}
@@ -0,0 +1,3292 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/french.txt; DO NOT EDIT.
+
+// Package fr provides the pre-built french langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package fr
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+	"E",
+	"En",
+	"En_",
+	"En__",
+	"En___",
+	"L",
+	"La",
+	"La_",
+	"La__",
+	"La___",
+	"Le",
+	"Les",
+	"Les_",
+	"Les__",
+	"_E",
+	"_En",
+	"_En_",
+	"_En__",
+	"_L",
+	"_La",
+	"_La_",
+	"_La__",
+	"_Le",
+	"_Les",
+	"_Les_",
+	"__E",
+	"__En",
+	"__En_",
+	"__L",
+	"__La",
+	"__La_",
+	"__Le",
+	"__Les",
+	"___E",
+	"___En",
+	"___L",
+	"___La",
+	"___Le",
+	"____E",
+	"____L",
+	"____a",
+	"____b",
+	"____c",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____i",
+	"____l",
+	"____m",
+	"____n",
+	"____o",
+	"____p",
+	"____q",
+	"____r",
+	"____s",
+	"____t",
+	"____u",
+	"____w",
+	"____\xc3",
+	"___a",
+	"___an",
+	"___ap",
+	"___au",
+	"___av",
+	"___b",
+	"___ba",
+	"___bo",
+	"___c",
+	"___ce",
+	"___cl",
+	"___co",
+	"___d",
+	"___d_",
+	"___da",
+	"___de",
+	"___do",
+	"___du",
+	"___d\xc3",
+	"___e",
+	"___em",
+	"___en",
+	"___et",
+	"___ex",
+	"___f",
+	"___fo",
+	"___fr",
+	"___g",
+	"___gr",
+	"___i",
+	"___id",
+	"___l",
+	"___la",
+	"___le",
+	"___li",
+	"___m",
+	"___mo",
+	"___m\xc3",
+	"___n",
+	"___n_",
+	"___no",
+	"___o",
+	"___on",
+	"___ou",
+	"___p",
+	"___pa",
+	"___pe",
+	"___ph",
+	"___pl",
+	"___po",
+	"___pr",
+	"___q",
+	"___qu",
+	"___r",
+	"___ra",
+	"___re",
+	"___r\xc3",
+	"___s",
+	"___s_",
+	"___se",
+	"___si",
+	"___st",
+	"___su",
+	"___s\xc3",
+	"___t",
+	"___ta",
+	"___te",
+	"___tr",
+	"___u",
+	"___un",
+	"___w",
+	"___we",
+	"___\xc3",
+	"___à",
+	"___é",
+	"__a",
+	"__an",
+	"__ana",
+	"__ap",
+	"__app",
+	"__au",
+	"__au_",
+	"__av",
+	"__ave",
+	"__b",
+	"__ba",
+	"__bar",
+	"__bo",
+	"__bon",
+	"__c",
+	"__ce",
+	"__ces",
+	"__cet",
+	"__cl",
+	"__cla",
+	"__co",
+	"__com",
+	"__con",
+	"__cou",
+	"__d",
+	"__d_",
+	"__d__",
+	"__da",
+	"__dan",
+	"__de",
+	"__de_",
+	"__des",
+	"__do",
+	"__don",
+	"__du",
+	"__du_",
+	"__d\xc3",
+	"__dé",
+	"__e",
+	"__em",
+	"__emp",
+	"__en",
+	"__ens",
+	"__et",
+	"__et_",
+	"__ex",
+	"__ext",
+	"__f",
+	"__fo",
+	"__fon",
+	"__fr",
+	"__fr\xc3",
+	"__g",
+	"__gr",
+	"__gra",
+	"__i",
+	"__id",
+	"__id\xc3",
+	"__l",
+	"__la",
+	"__la_",
+	"__lan",
+	"__le",
+	"__let",
+	"__li",
+	"__liv",
+	"__m",
+	"__mo",
+	"__moi",
+	"__mot",
+	"__m\xc3",
+	"__mê",
+	"__n",
+	"__n_",
+	"__n__",
+	"__no",
+	"__nom",
+	"__nou",
+	"__o",
+	"__on",
+	"__ont",
+	"__ou",
+	"__ou_",
+	"__p",
+	"__pa",
+	"__pag",
+	"__pas",
+	"__pe",
+	"__peu",
+	"__ph",
+	"__phr",
+	"__pl",
+	"__plu",
+	"__po",
+	"__pou",
+	"__pr",
+	"__pro",
+	"__q",
+	"__qu",
+	"__qui",
+	"__quo",
+	"__r",
+	"__ra",
+	"__rai",
+	"__re",
+	"__rec",
+	"__r\xc3",
+	"__ré",
+	"__s",
+	"__s_",
+	"__s__",
+	"__se",
+	"__se_",
+	"__ses",
+	"__si",
+	"__sim",
+	"__st",
+	"__sta",
+	"__su",
+	"__suf",
+	"__sur",
+	"__s\xc3",
+	"__sé",
+	"__t",
+	"__ta",
+	"__tap",
+	"__te",
+	"__ten",
+	"__tex",
+	"__tr",
+	"__tr\xc3",
+	"__u",
+	"__un",
+	"__un_",
+	"__une",
+	"__w",
+	"__we",
+	"__web",
+	"__\xc3",
+	"__à",
+	"__à_",
+	"__é",
+	"__éc",
+	"_a",
+	"_an",
+	"_ana",
+	"_anal",
+	"_ap",
+	"_app",
+	"_appe",
+	"_appu",
+	"_au",
+	"_au_",
+	"_au__",
+	"_av",
+	"_ave",
+	"_avec",
+	"_b",
+	"_ba",
+	"_bar",
+	"_barr",
+	"_bo",
+	"_bon",
+	"_bons",
+	"_c",
+	"_ce",
+	"_ces",
+	"_ces_",
+	"_cet",
+	"_cett",
+	"_cl",
+	"_cla",
+	"_clas",
+	"_co",
+	"_com",
+	"_comp",
+	"_con",
+	"_conf",
+	"_conn",
+	"_cons",
+	"_cou",
+	"_cour",
+	"_d",
+	"_d_",
+	"_d__",
+	"_d___",
+	"_da",
+	"_dan",
+	"_dans",
+	"_de",
+	"_de_",
+	"_de__",
+	"_des",
+	"_des_",
+	"_do",
+	"_don",
+	"_donn",
+	"_du",
+	"_du_",
+	"_du__",
+	"_d\xc3",
+	"_dé",
+	"_dét",
+	"_e",
+	"_em",
+	"_emp",
+	"_empr",
+	"_en",
+	"_ens",
+	"_ensu",
+	"_et",
+	"_et_",
+	"_et__",
+	"_ex",
+	"_ext",
+	"_extr",
+	"_f",
+	"_fo",
+	"_fon",
+	"_fonc",
+	"_fr",
+	"_fr\xc3",
+	"_fré",
+	"_g",
+	"_gr",
+	"_gra",
+	"_gram",
+	"_gran",
+	"_i",
+	"_id",
+	"_id\xc3",
+	"_idé",
+	"_l",
+	"_la",
+	"_la_",
+	"_la__",
+	"_lan",
+	"_lang",
+	"_le",
+	"_let",
+	"_lett",
+	"_li",
+	"_liv",
+	"_livr",
+	"_m",
+	"_mo",
+	"_moi",
+	"_moin",
+	"_mot",
+	"_moti",
+	"_m\xc3",
+	"_mê",
+	"_mêm",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_no",
+	"_nom",
+	"_nomb",
+	"_nou",
+	"_nouv",
+	"_o",
+	"_on",
+	"_ont",
+	"_ont_",
+	"_ou",
+	"_ou_",
+	"_ou__",
+	"_p",
+	"_pa",
+	"_pag",
+	"_page",
+	"_pas",
+	"_pass",
+	"_pe",
+	"_peu",
+	"_peut",
+	"_ph",
+	"_phr",
+	"_phra",
+	"_pl",
+	"_plu",
+	"_plus",
+	"_po",
+	"_pou",
+	"_pour",
+	"_pr",
+	"_pro",
+	"_prof",
+	"_q",
+	"_qu",
+	"_qui",
+	"_qui_",
+	"_quo",
+	"_quot",
+	"_r",
+	"_ra",
+	"_rai",
+	"_rais",
+	"_re",
+	"_rec",
+	"_rech",
+	"_r\xc3",
+	"_ré",
+	"_rép",
+	"_s",
+	"_s_",
+	"_s__",
+	"_s___",
+	"_se",
+	"_se_",
+	"_se__",
+	"_ses",
+	"_ses_",
+	"_si",
+	"_sim",
+	"_simp",
+	"_st",
+	"_sta",
+	"_stab",
+	"_su",
+	"_suf",
+	"_suff",
+	"_sur",
+	"_sur_",
+	"_s\xc3",
+	"_sé",
+	"_séq",
+	"_t",
+	"_ta",
+	"_tap",
+	"_tap\xc3",
+	"_te",
+	"_ten",
+	"_tend",
+	"_tex",
+	"_text",
+	"_tr",
+	"_tr\xc3",
+	"_trè",
+	"_u",
+	"_un",
+	"_un_",
+	"_un__",
+	"_une",
+	"_une_",
+	"_w",
+	"_we",
+	"_web",
+	"_web_",
+	"_\xc3",
+	"_à",
+	"_à_",
+	"_à__",
+	"_é",
+	"_éc",
+	"_éch",
+	"_écr",
+	"a",
+	"a_",
+	"a__",
+	"a___",
+	"a____",
+	"ab",
+	"abl",
+	"able",
+	"able_",
+	"ac",
+	"act",
+	"acte",
+	"acte_",
+	"ag",
+	"age",
+	"age_",
+	"age__",
+	"ai",
+	"ais",
+	"aiso",
+	"aison",
+	"ait",
+	"aits",
+	"aits_",
+	"al",
+	"aly",
+	"alys",
+	"alyse",
+	"am",
+	"amm",
+	"amme",
+	"ammen",
+	"ammes",
+	"an",
+	"ana",
+	"anal",
+	"analy",
+	"anc",
+	"ance",
+	"ance_",
+	"and",
+	"and_",
+	"and__",
+	"ang",
+	"angu",
+	"angue",
+	"ans",
+	"ans_",
+	"ans__",
+	"ant",
+	"ant_",
+	"ant__",
+	"anti",
+	"antil",
+	"ap",
+	"app",
+	"appe",
+	"appel",
+	"appu",
+	"appui",
+	"ap\xc3",
+	"apé",
+	"apée",
+	"ar",
+	"are",
+	"are_",
+	"are__",
+	"arer",
+	"arer_",
+	"arr",
+	"arre",
+	"arre_",
+	"as",
+	"ase",
+	"ase_",
+	"ase__",
+	"ass",
+	"assa",
+	"assag",
+	"assan",
+	"au",
+	"au_",
+	"au__",
+	"au___",
+	"av",
+	"ave",
+	"avec",
+	"avec_",
+	"b",
+	"b_",
+	"b__",
+	"b___",
+	"b____",
+	"ba",
+	"bar",
+	"barr",
+	"barre",
+	"bl",
+	"ble",
+	"ble_",
+	"ble__",
+	"bo",
+	"bon",
+	"bons",
+	"bons_",
+	"br",
+	"bre",
+	"breu",
+	"breus",
+	"c",
+	"c_",
+	"c__",
+	"c___",
+	"c____",
+	"ce",
+	"ce_",
+	"ce__",
+	"ce___",
+	"ces",
+	"ces_",
+	"ces__",
+	"cet",
+	"cett",
+	"cette",
+	"ch",
+	"cha",
+	"chan",
+	"chant",
+	"che",
+	"che_",
+	"che__",
+	"cher",
+	"cherc",
+	"cl",
+	"cla",
+	"clas",
+	"class",
+	"co",
+	"com",
+	"comp",
+	"compa",
+	"con",
+	"conf",
+	"confi",
+	"conn",
+	"connu",
+	"cons",
+	"const",
+	"cou",
+	"cour",
+	"court",
+	"cr",
+	"cri",
+	"crit",
+	"critu",
+	"ct",
+	"cte",
+	"cte_",
+	"cte__",
+	"cteu",
+	"cteur",
+	"cti",
+	"ctio",
+	"ction",
+	"d",
+	"d_",
+	"d__",
+	"d___",
+	"d____",
+	"da",
+	"dan",
+	"danc",
+	"dance",
+	"dans",
+	"dans_",
+	"de",
+	"de_",
+	"de__",
+	"de___",
+	"des",
+	"des_",
+	"des__",
+	"di",
+	"die",
+	"dien",
+	"dienn",
+	"do",
+	"don",
+	"donn",
+	"donn\xc3",
+	"du",
+	"du_",
+	"du__",
+	"du___",
+	"d\xc3",
+	"dé",
+	"dée",
+	"dée_",
+	"dét",
+	"déte",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"ea",
+	"eau",
+	"eau_",
+	"eau__",
+	"eb",
+	"eb_",
+	"eb__",
+	"eb___",
+	"ec",
+	"ec_",
+	"ec__",
+	"ec___",
+	"ech",
+	"eche",
+	"echer",
+	"ect",
+	"ecte",
+	"ecteu",
+	"ecti",
+	"ectio",
+	"ei",
+	"ein",
+	"eint",
+	"einte",
+	"el",
+	"el\xc3",
+	"elé",
+	"elée",
+	"em",
+	"emp",
+	"empr",
+	"empre",
+	"en",
+	"enc",
+	"ence",
+	"ence_",
+	"ences",
+	"end",
+	"enda",
+	"endan",
+	"enn",
+	"enne",
+	"enne_",
+	"ens",
+	"ensu",
+	"ensui",
+	"ent",
+	"ent_",
+	"ent__",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"erc",
+	"erch",
+	"erche",
+	"es",
+	"es_",
+	"es__",
+	"es___",
+	"et",
+	"et_",
+	"et__",
+	"et___",
+	"ett",
+	"ette",
+	"ette_",
+	"ettr",
+	"ettre",
+	"eu",
+	"eur",
+	"eur_",
+	"eur__",
+	"eurs",
+	"eurs_",
+	"eus",
+	"euse",
+	"euses",
+	"eut",
+	"eut_",
+	"eut__",
+	"ex",
+	"ext",
+	"exte",
+	"exte_",
+	"extr",
+	"extra",
+	"f",
+	"ff",
+	"ffi",
+	"ffis",
+	"ffisa",
+	"fi",
+	"fia",
+	"fian",
+	"fianc",
+	"fil",
+	"fils",
+	"fils_",
+	"fis",
+	"fisa",
+	"fisam",
+	"fo",
+	"fon",
+	"fonc",
+	"fonct",
+	"fr",
+	"fr\xc3",
+	"fré",
+	"fréq",
+	"fs",
+	"fs_",
+	"fs__",
+	"fs___",
+	"g",
+	"ge",
+	"ge_",
+	"ge__",
+	"ge___",
+	"gr",
+	"gra",
+	"gram",
+	"gramm",
+	"gran",
+	"grand",
+	"gu",
+	"gue",
+	"gue_",
+	"gue__",
+	"gues",
+	"gues_",
+	"h",
+	"ha",
+	"han",
+	"hant",
+	"hanti",
+	"he",
+	"he_",
+	"he__",
+	"he___",
+	"her",
+	"herc",
+	"herch",
+	"hr",
+	"hra",
+	"hras",
+	"hrase",
+	"i",
+	"i_",
+	"i__",
+	"i___",
+	"i____",
+	"ia",
+	"ian",
+	"ianc",
+	"iance",
+	"id",
+	"idi",
+	"idie",
+	"idien",
+	"id\xc3",
+	"idé",
+	"idée",
+	"ie",
+	"ien",
+	"ienn",
+	"ienne",
+	"ient",
+	"ient_",
+	"if",
+	"ifs",
+	"ifs_",
+	"ifs__",
+	"il",
+	"ill",
+	"illo",
+	"illon",
+	"ils",
+	"ils_",
+	"ils__",
+	"im",
+	"imp",
+	"impl",
+	"imple",
+	"in",
+	"ins",
+	"ins_",
+	"ins__",
+	"int",
+	"inte",
+	"inte_",
+	"io",
+	"ion",
+	"ion_",
+	"ion__",
+	"ionn",
+	"ionne",
+	"ir",
+	"ire",
+	"ire_",
+	"ire__",
+	"is",
+	"isa",
+	"isam",
+	"isamm",
+	"iso",
+	"ison",
+	"isonn",
+	"it",
+	"ite",
+	"ite_",
+	"ite__",
+	"its",
+	"its_",
+	"its__",
+	"itu",
+	"itur",
+	"iture",
+	"iv",
+	"ivr",
+	"ivre",
+	"ivre_",
+	"l",
+	"la",
+	"la_",
+	"la__",
+	"la___",
+	"lan",
+	"lang",
+	"langu",
+	"las",
+	"lass",
+	"lassa",
+	"le",
+	"le_",
+	"le__",
+	"le___",
+	"let",
+	"lett",
+	"lettr",
+	"li",
+	"liv",
+	"livr",
+	"livre",
+	"ll",
+	"llo",
+	"llon",
+	"llon_",
+	"lo",
+	"lon",
+	"lon_",
+	"lon__",
+	"ls",
+	"ls_",
+	"ls__",
+	"ls___",
+	"lu",
+	"lus",
+	"lus_",
+	"lus__",
+	"ly",
+	"lys",
+	"lyse",
+	"lyse_",
+	"l\xc3",
+	"lé",
+	"lée",
+	"lées",
+	"m",
+	"mb",
+	"mbr",
+	"mbre",
+	"mbreu",
+	"me",
+	"me_",
+	"me__",
+	"me___",
+	"men",
+	"ment",
+	"ment_",
+	"mes",
+	"mes_",
+	"mes__",
+	"mm",
+	"mme",
+	"mmen",
+	"mment",
+	"mmes",
+	"mmes_",
+	"mo",
+	"moi",
+	"moin",
+	"moins",
+	"mot",
+	"moti",
+	"motif",
+	"mp",
+	"mpa",
+	"mpac",
+	"mpact",
+	"mpar",
+	"mpare",
+	"mpl",
+	"mple",
+	"mple_",
+	"mpr",
+	"mpre",
+	"mprei",
+	"m\xc3",
+	"mê",
+	"mêm",
+	"même",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"nab",
+	"nabl",
+	"nable",
+	"nal",
+	"naly",
+	"nalys",
+	"nc",
+	"nce",
+	"nce_",
+	"nce__",
+	"nces",
+	"nces_",
+	"nct",
+	"ncti",
+	"nctio",
+	"nd",
+	"nd_",
+	"nd__",
+	"nd___",
+	"nda",
+	"ndan",
+	"ndanc",
+	"ne",
+	"ne_",
+	"ne__",
+	"ne___",
+	"nf",
+	"nfi",
+	"nfia",
+	"nfian",
+	"ng",
+	"ngu",
+	"ngue",
+	"ngue_",
+	"ngues",
+	"nn",
+	"nna",
+	"nnab",
+	"nnabl",
+	"nne",
+	"nne_",
+	"nne__",
+	"nnu",
+	"nnus",
+	"nnus_",
+	"nn\xc3",
+	"nné",
+	"nnée",
+	"no",
+	"nom",
+	"nomb",
+	"nombr",
+	"nou",
+	"nouv",
+	"nouve",
+	"ns",
+	"ns_",
+	"ns__",
+	"ns___",
+	"nst",
+	"nstr",
+	"nstru",
+	"nsu",
+	"nsui",
+	"nsuit",
+	"nt",
+	"nt_",
+	"nt__",
+	"nt___",
+	"nte",
+	"nte_",
+	"nte__",
+	"nti",
+	"ntil",
+	"ntill",
+	"nu",
+	"nus",
+	"nus_",
+	"nus__",
+	"n\xc3",
+	"né",
+	"née",
+	"née_",
+	"o",
+	"of",
+	"ofi",
+	"ofil",
+	"ofils",
+	"oi",
+	"oin",
+	"oins",
+	"oins_",
+	"om",
+	"omb",
+	"ombr",
+	"ombre",
+	"omp",
+	"ompa",
+	"ompac",
+	"ompar",
+	"on",
+	"on_",
+	"on__",
+	"on___",
+	"onc",
+	"onct",
+	"oncti",
+	"onf",
+	"onfi",
+	"onfia",
+	"onn",
+	"onna",
+	"onnab",
+	"onne",
+	"onne_",
+	"onnu",
+	"onnus",
+	"onn\xc3",
+	"onné",
+	"ons",
+	"ons_",
+	"ons__",
+	"onst",
+	"onstr",
+	"ont",
+	"ont_",
+	"ont__",
+	"ot",
+	"oti",
+	"otid",
+	"otidi",
+	"otif",
+	"otifs",
+	"ou",
+	"ou_",
+	"ou__",
+	"ou___",
+	"our",
+	"our_",
+	"our__",
+	"ourt",
+	"ourt_",
+	"ourte",
+	"ourts",
+	"ouv",
+	"ouve",
+	"ouvea",
+	"p",
+	"pa",
+	"pac",
+	"pact",
+	"pacte",
+	"pag",
+	"page",
+	"page_",
+	"par",
+	"pare",
+	"pare_",
+	"parer",
+	"pas",
+	"pass",
+	"passa",
+	"pe",
+	"pel",
+	"pel\xc3",
+	"pelé",
+	"peu",
+	"peut",
+	"peut_",
+	"ph",
+	"phr",
+	"phra",
+	"phras",
+	"pl",
+	"ple",
+	"ple_",
+	"ple__",
+	"plu",
+	"plus",
+	"plus_",
+	"po",
+	"pou",
+	"pour",
+	"pour_",
+	"pp",
+	"ppe",
+	"ppel",
+	"ppel\xc3",
+	"ppu",
+	"ppui",
+	"ppuie",
+	"pr",
+	"pre",
+	"prei",
+	"prein",
+	"pro",
+	"prof",
+	"profi",
+	"pu",
+	"pui",
+	"puie",
+	"puien",
+	"p\xc3",
+	"pé",
+	"pée",
+	"pée_",
+	"pét",
+	"péte",
+	"q",
+	"qu",
+	"que",
+	"quen",
+	"quenc",
+	"quent",
+	"qui",
+	"qui_",
+	"qui__",
+	"quo",
+	"quot",
+	"quoti",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"rai",
+	"rais",
+	"raiso",
+	"rait",
+	"raits",
+	"ram",
+	"ramm",
+	"ramme",
+	"ran",
+	"rand",
+	"rand_",
+	"ras",
+	"rase",
+	"rase_",
+	"rc",
+	"rch",
+	"rche",
+	"rche_",
+	"re",
+	"re_",
+	"re__",
+	"re___",
+	"rec",
+	"rech",
+	"reche",
+	"rei",
+	"rein",
+	"reint",
+	"rer",
+	"rer_",
+	"rer__",
+	"res",
+	"res_",
+	"res__",
+	"reu",
+	"reus",
+	"reuse",
+	"ri",
+	"rit",
+	"ritu",
+	"ritur",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"rr",
+	"rre",
+	"rre_",
+	"rre__",
+	"rs",
+	"rs_",
+	"rs__",
+	"rs___",
+	"rt",
+	"rt_",
+	"rt__",
+	"rt___",
+	"rte",
+	"rtes",
+	"rtes_",
+	"rts",
+	"rts_",
+	"rts__",
+	"ru",
+	"rui",
+	"ruir",
+	"ruire",
+	"r\xc3",
+	"rè",
+	"rès",
+	"rès_",
+	"ré",
+	"rép",
+	"rép\xc3",
+	"réq",
+	"réqu",
+	"s",
+	"s_",
+	"s__",
+	"s___",
+	"s____",
+	"sa",
+	"sag",
+	"sage",
+	"sage_",
+	"sam",
+	"samm",
+	"samme",
+	"san",
+	"sant",
+	"sant_",
+	"se",
+	"se_",
+	"se__",
+	"se___",
+	"ses",
+	"ses_",
+	"ses__",
+	"si",
+	"sim",
+	"simp",
+	"simpl",
+	"so",
+	"son",
+	"sonn",
+	"sonna",
+	"ss",
+	"ssa",
+	"ssag",
+	"ssage",
+	"ssan",
+	"ssant",
+	"st",
+	"sta",
+	"stab",
+	"stabl",
+	"str",
+	"stru",
+	"strui",
+	"su",
+	"suf",
+	"suff",
+	"suffi",
+	"sui",
+	"suit",
+	"suite",
+	"sur",
+	"sur_",
+	"sur__",
+	"s\xc3",
+	"sé",
+	"séq",
+	"séqu",
+	"t",
+	"t_",
+	"t__",
+	"t___",
+	"t____",
+	"ta",
+	"tab",
+	"tabl",
+	"table",
+	"tap",
+	"tap\xc3",
+	"tapé",
+	"te",
+	"te_",
+	"te__",
+	"te___",
+	"tec",
+	"tect",
+	"tecte",
+	"tecti",
+	"ten",
+	"tend",
+	"tenda",
+	"ter",
+	"ter_",
+	"ter__",
+	"tes",
+	"tes_",
+	"tes__",
+	"teu",
+	"teur",
+	"teur_",
+	"teurs",
+	"tex",
+	"text",
+	"texte",
+	"ti",
+	"tid",
+	"tidi",
+	"tidie",
+	"tif",
+	"tifs",
+	"tifs_",
+	"til",
+	"till",
+	"tillo",
+	"tio",
+	"tion",
+	"tion_",
+	"tionn",
+	"tr",
+	"tra",
+	"trai",
+	"trait",
+	"tre",
+	"tres",
+	"tres_",
+	"tru",
+	"trui",
+	"truir",
+	"tr\xc3",
+	"trè",
+	"très",
+	"ts",
+	"ts_",
+	"ts__",
+	"ts___",
+	"tt",
+	"tte",
+	"tte_",
+	"tte__",
+	"ttr",
+	"ttre",
+	"ttres",
+	"tu",
+	"tur",
+	"ture",
+	"ture_",
+	"u",
+	"u_",
+	"u__",
+	"u___",
+	"u____",
+	"ue",
+	"ue_",
+	"ue__",
+	"ue___",
+	"uen",
+	"uenc",
+	"uence",
+	"uent",
+	"uent_",
+	"ues",
+	"ues_",
+	"ues__",
+	"uf",
+	"uff",
+	"uffi",
+	"uffis",
+	"ui",
+	"ui_",
+	"ui__",
+	"ui___",
+	"uie",
+	"uien",
+	"uient",
+	"uir",
+	"uire",
+	"uire_",
+	"uit",
+	"uite",
+	"uite_",
+	"un",
+	"un_",
+	"un__",
+	"un___",
+	"une",
+	"une_",
+	"une__",
+	"uo",
+	"uot",
+	"uoti",
+	"uotid",
+	"ur",
+	"ur_",
+	"ur__",
+	"ur___",
+	"ure",
+	"ure_",
+	"ure__",
+	"urs",
+	"urs_",
+	"urs__",
+	"urt",
+	"urt_",
+	"urt__",
+	"urte",
+	"urtes",
+	"urts",
+	"urts_",
+	"us",
+	"us_",
+	"us__",
+	"us___",
+	"use",
+	"uses",
+	"uses_",
+	"ut",
+	"ut_",
+	"ut__",
+	"ut___",
+	"uv",
+	"uve",
+	"uvea",
+	"uveau",
+	"v",
+	"ve",
+	"vea",
+	"veau",
+	"veau_",
+	"vec",
+	"vec_",
+	"vec__",
+	"vr",
+	"vre",
+	"vre_",
+	"vre__",
+	"w",
+	"we",
+	"web",
+	"web_",
+	"web__",
+	"x",
+	"xt",
+	"xte",
+	"xte_",
+	"xte__",
+	"xtr",
+	"xtra",
+	"xtrai",
+	"y",
+	"ys",
+	"yse",
+	"yse_",
+	"yse__",
+	"\xa8",
+	"\xa8s",
+	"\xa8s_",
+	"\xa8s__",
+	"\xa8s___",
+	"\xa9",
+	"\xa9c",
+	"\xa9ch",
+	"\xa9cha",
+	"\xa9chan",
+	"\xa9cr",
+	"\xa9cri",
+	"\xa9crit",
+	"\xa9e",
+	"\xa9e_",
+	"\xa9e__",
+	"\xa9e___",
+	"\xa9es",
+	"\xa9es_",
+	"\xa9es__",
+	"\xa9p",
+	"\xa9p\xc3",
+	"\xa9pé",
+	"\xa9pét",
+	"\xa9q",
+	"\xa9qu",
+	"\xa9que",
+	"\xa9quen",
+	"\xa9t",
+	"\xa9te",
+	"\xa9tec",
+	"\xa9tect",
+	"\xa9ter",
+	"\xa9ter_",
+	"\xaa",
+	"\xaam",
+	"\xaame",
+	"\xaame_",
+	"\xaame__",
+	"\xc3",
+	"à",
+	"à_",
+	"à__",
+	"à___",
+	"è",
+	"ès",
+	"ès_",
+	"ès__",
+	"é",
+	"éc",
+	"éch",
+	"écha",
+	"écr",
+	"écri",
+	"ée",
+	"ée_",
+	"ée__",
+	"ées",
+	"ées_",
+	"ép",
+	"ép\xc3",
+	"épé",
+	"éq",
+	"équ",
+	"éque",
+	"ét",
+	"éte",
+	"étec",
+	"éter",
+	"ê",
+	"êm",
+	"ême",
+	"ême_",
+}
+
+var ranks = []uint16{
+	1634,
+	1633,
+	1632,
+	1631,
+	1630,
+	388,
+	674,
+	673,
+	672,
+	671,
+	1629,
+	1628,
+	1627,
+	1626,
+	1625,
+	1624,
+	1623,
+	1622,
+	387,
+	670,
+	669,
+	668,
+	1621,
+	1620,
+	1619,
+	1618,
+	1617,
+	1616,
+	386,
+	667,
+	666,
+	1615,
+	1614,
+	1613,
+	1612,
+	385,
+	665,
+	1611,
+	1610,
+	384,
+	108,
+	664,
+	48,
+	26,
+	124,
+	229,
+	383,
+	1609,
+	100,
+	228,
+	227,
+	663,
+	70,
+	662,
+	382,
+	99,
+	159,
+	42,
+	1608,
+	226,
+	107,
+	1607,
+	661,
+	1606,
+	381,
+	660,
+	1605,
+	1604,
+	47,
+	659,
+	1603,
+	69,
+	25,
+	380,
+	658,
+	68,
+	1602,
+	1601,
+	379,
+	123,
+	657,
+	1600,
+	656,
+	1599,
+	225,
+	1598,
+	378,
+	377,
+	376,
+	1597,
+	1596,
+	98,
+	158,
+	655,
+	1595,
+	224,
+	654,
+	653,
+	223,
+	652,
+	651,
+	650,
+	1594,
+	1593,
+	67,
+	649,
+	1592,
+	1591,
+	1590,
+	375,
+	1589,
+	648,
+	647,
+	374,
+	1588,
+	1587,
+	1586,
+	97,
+	1585,
+	646,
+	1584,
+	1583,
+	645,
+	1582,
+	157,
+	1581,
+	373,
+	1580,
+	41,
+	40,
+	1579,
+	1578,
+	222,
+	644,
+	643,
+	106,
+	1577,
+	1576,
+	642,
+	641,
+	1575,
+	1574,
+	372,
+	371,
+	640,
+	1573,
+	1572,
+	1571,
+	1570,
+	46,
+	639,
+	1569,
+	1568,
+	1567,
+	1566,
+	66,
+	370,
+	369,
+	368,
+	24,
+	367,
+	366,
+	638,
+	637,
+	65,
+	96,
+	1565,
+	1564,
+	1563,
+	1562,
+	1561,
+	365,
+	364,
+	122,
+	636,
+	635,
+	1560,
+	1559,
+	634,
+	633,
+	1558,
+	1557,
+	221,
+	1556,
+	1555,
+	363,
+	362,
+	361,
+	360,
+	359,
+	1554,
+	1553,
+	1552,
+	95,
+	156,
+	1551,
+	220,
+	632,
+	631,
+	1550,
+	1549,
+	219,
+	630,
+	1548,
+	1547,
+	629,
+	628,
+	218,
+	627,
+	626,
+	625,
+	1546,
+	1545,
+	624,
+	1544,
+	1543,
+	1542,
+	1541,
+	64,
+	623,
+	1540,
+	1539,
+	1538,
+	1537,
+	1536,
+	1535,
+	1534,
+	1533,
+	358,
+	357,
+	1532,
+	1531,
+	622,
+	621,
+	1530,
+	1529,
+	356,
+	1528,
+	1527,
+	1526,
+	1525,
+	1524,
+	1523,
+	94,
+	1522,
+	1521,
+	620,
+	1520,
+	1519,
+	1518,
+	1517,
+	1516,
+	1515,
+	619,
+	1514,
+	1513,
+	1512,
+	1511,
+	155,
+	1510,
+	1509,
+	355,
+	1508,
+	618,
+	1507,
+	1506,
+	39,
+	38,
+	154,
+	93,
+	1505,
+	1504,
+	1503,
+	217,
+	617,
+	616,
+	615,
+	614,
+	105,
+	1502,
+	1501,
+	1500,
+	613,
+	612,
+	1499,
+	1498,
+	1497,
+	1496,
+	1495,
+	354,
+	353,
+	352,
+	611,
+	1494,
+	1493,
+	1492,
+	1491,
+	1490,
+	1489,
+	45,
+	610,
+	1488,
+	1487,
+	1486,
+	1485,
+	1484,
+	1483,
+	1482,
+	63,
+	351,
+	350,
+	349,
+	1481,
+	1480,
+	1479,
+	348,
+	347,
+	23,
+	346,
+	345,
+	344,
+	609,
+	608,
+	607,
+	62,
+	92,
+	91,
+	1478,
+	1477,
+	1476,
+	1475,
+	1474,
+	1473,
+	1472,
+	1471,
+	343,
+	342,
+	341,
+	121,
+	606,
+	605,
+	604,
+	1470,
+	1469,
+	1468,
+	603,
+	602,
+	601,
+	1467,
+	1466,
+	1465,
+	216,
+	1464,
+	1463,
+	1462,
+	340,
+	339,
+	338,
+	337,
+	336,
+	335,
+	600,
+	1461,
+	1460,
+	1459,
+	1458,
+	1457,
+	90,
+	153,
+	1456,
+	1455,
+	215,
+	214,
+	599,
+	598,
+	597,
+	1454,
+	1453,
+	1452,
+	213,
+	596,
+	1451,
+	1450,
+	1449,
+	1448,
+	595,
+	594,
+	593,
+	212,
+	592,
+	591,
+	590,
+	589,
+	1447,
+	1446,
+	1445,
+	1444,
+	588,
+	1443,
+	1442,
+	1441,
+	1440,
+	1439,
+	1438,
+	61,
+	587,
+	1437,
+	1436,
+	1435,
+	1434,
+	1433,
+	1432,
+	1431,
+	1430,
+	1429,
+	1428,
+	1427,
+	1426,
+	1425,
+	334,
+	333,
+	332,
+	1424,
+	1423,
+	1422,
+	586,
+	585,
+	1421,
+	1420,
+	1419,
+	1418,
+	331,
+	1417,
+	1416,
+	1415,
+	1414,
+	1413,
+	1412,
+	1411,
+	1410,
+	1409,
+	89,
+	1408,
+	1407,
+	1406,
+	584,
+	1405,
+	1404,
+	1403,
+	1402,
+	1401,
+	1400,
+	1399,
+	1398,
+	1397,
+	1396,
+	583,
+	1395,
+	1394,
+	1393,
+	1392,
+	1391,
+	1390,
+	1389,
+	152,
+	1388,
+	1387,
+	1386,
+	330,
+	1385,
+	1384,
+	582,
+	581,
+	1383,
+	1382,
+	1381,
+	37,
+	36,
+	151,
+	150,
+	88,
+	87,
+	1380,
+	1379,
+	1378,
+	1377,
+	211,
+	580,
+	579,
+	578,
+	577,
+	576,
+	1376,
+	1375,
+	10,
+	329,
+	328,
+	327,
+	326,
+	575,
+	574,
+	573,
+	572,
+	1374,
+	1373,
+	1372,
+	1371,
+	571,
+	570,
+	569,
+	568,
+	567,
+	1370,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	1364,
+	1363,
+	1362,
+	1361,
+	325,
+	324,
+	323,
+	1360,
+	566,
+	44,
+	1359,
+	1358,
+	1357,
+	565,
+	564,
+	563,
+	1356,
+	1355,
+	1354,
+	210,
+	209,
+	208,
+	562,
+	561,
+	560,
+	559,
+	1353,
+	1352,
+	1351,
+	1350,
+	322,
+	558,
+	1349,
+	1348,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	321,
+	557,
+	1342,
+	1341,
+	1340,
+	1339,
+	1338,
+	1337,
+	1336,
+	320,
+	1335,
+	1334,
+	1333,
+	556,
+	555,
+	1332,
+	1331,
+	554,
+	553,
+	552,
+	551,
+	319,
+	318,
+	317,
+	316,
+	120,
+	1330,
+	1329,
+	1328,
+	1327,
+	1326,
+	1325,
+	1324,
+	1323,
+	550,
+	549,
+	548,
+	547,
+	1322,
+	1321,
+	1320,
+	1319,
+	1318,
+	1317,
+	1316,
+	1315,
+	12,
+	315,
+	314,
+	313,
+	312,
+	119,
+	311,
+	310,
+	309,
+	546,
+	545,
+	544,
+	1314,
+	1313,
+	1312,
+	308,
+	1311,
+	1310,
+	1309,
+	543,
+	1308,
+	1307,
+	1306,
+	1305,
+	1304,
+	1303,
+	1302,
+	1301,
+	60,
+	307,
+	306,
+	305,
+	304,
+	1300,
+	1299,
+	1298,
+	1297,
+	1296,
+	1295,
+	303,
+	302,
+	301,
+	1294,
+	1293,
+	1292,
+	1291,
+	149,
+	300,
+	1290,
+	1289,
+	542,
+	541,
+	540,
+	539,
+	538,
+	14,
+	207,
+	206,
+	205,
+	204,
+	299,
+	298,
+	1288,
+	1287,
+	537,
+	536,
+	59,
+	86,
+	85,
+	84,
+	1286,
+	1285,
+	1284,
+	1283,
+	1282,
+	1281,
+	1280,
+	1279,
+	1278,
+	1277,
+	1276,
+	1275,
+	1274,
+	1273,
+	1272,
+	203,
+	202,
+	1271,
+	1270,
+	297,
+	296,
+	1,
+	8,
+	7,
+	6,
+	5,
+	1269,
+	1268,
+	1267,
+	1266,
+	1265,
+	1264,
+	1263,
+	1262,
+	104,
+	295,
+	294,
+	293,
+	1261,
+	1260,
+	1259,
+	292,
+	535,
+	534,
+	1258,
+	1257,
+	533,
+	532,
+	531,
+	530,
+	1256,
+	1255,
+	1254,
+	1253,
+	529,
+	528,
+	527,
+	526,
+	58,
+	525,
+	524,
+	1252,
+	1251,
+	1250,
+	1249,
+	1248,
+	1247,
+	1246,
+	1245,
+	1244,
+	1243,
+	1242,
+	201,
+	200,
+	199,
+	523,
+	1241,
+	1240,
+	1239,
+	1238,
+	1237,
+	1236,
+	35,
+	34,
+	33,
+	32,
+	148,
+	522,
+	521,
+	520,
+	291,
+	1235,
+	1234,
+	519,
+	518,
+	198,
+	517,
+	1233,
+	1232,
+	1231,
+	1230,
+	1229,
+	1228,
+	1227,
+	1226,
+	1225,
+	1224,
+	290,
+	289,
+	516,
+	515,
+	1223,
+	1222,
+	57,
+	1221,
+	1220,
+	1219,
+	1218,
+	288,
+	1217,
+	1216,
+	1215,
+	1214,
+	1213,
+	1212,
+	1211,
+	1210,
+	1209,
+	1208,
+	1207,
+	1206,
+	1205,
+	287,
+	286,
+	285,
+	284,
+	1204,
+	1203,
+	1202,
+	1201,
+	56,
+	514,
+	513,
+	512,
+	511,
+	283,
+	282,
+	510,
+	509,
+	1200,
+	1199,
+	197,
+	196,
+	281,
+	280,
+	1198,
+	1197,
+	195,
+	1196,
+	1195,
+	1194,
+	1193,
+	508,
+	1192,
+	1191,
+	1190,
+	1189,
+	1188,
+	1187,
+	1186,
+	1185,
+	1184,
+	1183,
+	19,
+	1182,
+	1181,
+	1180,
+	1179,
+	1178,
+	1177,
+	1176,
+	1175,
+	507,
+	1174,
+	1173,
+	1172,
+	1171,
+	1170,
+	1169,
+	506,
+	505,
+	1168,
+	1167,
+	1166,
+	1165,
+	1164,
+	1163,
+	1162,
+	1161,
+	504,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	1155,
+	1154,
+	1153,
+	1152,
+	1151,
+	279,
+	1150,
+	1149,
+	1148,
+	503,
+	502,
+	501,
+	500,
+	499,
+	1147,
+	1146,
+	1145,
+	1144,
+	1143,
+	1142,
+	1141,
+	1140,
+	498,
+	1139,
+	1138,
+	1137,
+	1136,
+	1135,
+	1134,
+	278,
+	1133,
+	1132,
+	1131,
+	1130,
+	1129,
+	1128,
+	1127,
+	1126,
+	1125,
+	1124,
+	1123,
+	1122,
+	1121,
+	27,
+	118,
+	1120,
+	1119,
+	1118,
+	194,
+	193,
+	192,
+	1117,
+	1116,
+	1115,
+	147,
+	277,
+	276,
+	275,
+	497,
+	496,
+	495,
+	1114,
+	1113,
+	1112,
+	1111,
+	1110,
+	1109,
+	1108,
+	1107,
+	1106,
+	1105,
+	1104,
+	1103,
+	1102,
+	1101,
+	1100,
+	1099,
+	1098,
+	1097,
+	1096,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	1088,
+	1087,
+	22,
+	1086,
+	1085,
+	1084,
+	1083,
+	146,
+	494,
+	493,
+	492,
+	1082,
+	1081,
+	1080,
+	491,
+	490,
+	489,
+	274,
+	273,
+	1079,
+	1078,
+	488,
+	487,
+	486,
+	1077,
+	1076,
+	1075,
+	1074,
+	1073,
+	1072,
+	117,
+	272,
+	1071,
+	1070,
+	485,
+	484,
+	1069,
+	1068,
+	1067,
+	483,
+	482,
+	481,
+	480,
+	479,
+	478,
+	477,
+	2,
+	83,
+	82,
+	81,
+	80,
+	476,
+	1066,
+	1065,
+	1064,
+	1063,
+	1062,
+	1061,
+	145,
+	191,
+	271,
+	270,
+	1060,
+	1059,
+	1058,
+	1057,
+	1056,
+	475,
+	1055,
+	1054,
+	1053,
+	1052,
+	1051,
+	1050,
+	54,
+	53,
+	52,
+	51,
+	1049,
+	1048,
+	1047,
+	1046,
+	190,
+	189,
+	188,
+	269,
+	1045,
+	144,
+	1044,
+	1043,
+	1042,
+	474,
+	473,
+	472,
+	1041,
+	1040,
+	1039,
+	1038,
+	1037,
+	1036,
+	471,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	1030,
+	116,
+	187,
+	186,
+	185,
+	1029,
+	1028,
+	1027,
+	1026,
+	1025,
+	1024,
+	55,
+	115,
+	114,
+	113,
+	470,
+	469,
+	468,
+	1023,
+	1022,
+	1021,
+	1020,
+	1019,
+	1018,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	13,
+	1012,
+	1011,
+	1010,
+	1009,
+	1008,
+	1007,
+	1006,
+	1005,
+	184,
+	1004,
+	1003,
+	1002,
+	268,
+	267,
+	1001,
+	467,
+	49,
+	466,
+	465,
+	464,
+	1000,
+	999,
+	998,
+	997,
+	996,
+	995,
+	183,
+	994,
+	993,
+	992,
+	991,
+	990,
+	989,
+	988,
+	987,
+	463,
+	986,
+	985,
+	984,
+	983,
+	982,
+	981,
+	980,
+	462,
+	461,
+	979,
+	978,
+	977,
+	976,
+	79,
+	975,
+	974,
+	973,
+	112,
+	266,
+	265,
+	264,
+	972,
+	971,
+	970,
+	969,
+	968,
+	967,
+	20,
+	143,
+	966,
+	965,
+	964,
+	963,
+	962,
+	961,
+	460,
+	459,
+	960,
+	959,
+	958,
+	957,
+	956,
+	458,
+	955,
+	954,
+	953,
+	952,
+	951,
+	950,
+	949,
+	948,
+	947,
+	946,
+	457,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	263,
+	262,
+	261,
+	260,
+	456,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	259,
+	455,
+	454,
+	453,
+	933,
+	932,
+	931,
+	930,
+	929,
+	928,
+	927,
+	452,
+	451,
+	926,
+	925,
+	924,
+	923,
+	111,
+	110,
+	182,
+	181,
+	450,
+	449,
+	922,
+	921,
+	920,
+	919,
+	918,
+	917,
+	11,
+	142,
+	141,
+	140,
+	139,
+	109,
+	448,
+	916,
+	915,
+	914,
+	913,
+	447,
+	446,
+	445,
+	912,
+	911,
+	910,
+	909,
+	908,
+	907,
+	906,
+	905,
+	904,
+	903,
+	43,
+	138,
+	137,
+	136,
+	902,
+	901,
+	900,
+	444,
+	443,
+	442,
+	899,
+	898,
+	897,
+	441,
+	440,
+	439,
+	896,
+	895,
+	894,
+	893,
+	892,
+	891,
+	890,
+	889,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	258,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	135,
+	864,
+	863,
+	862,
+	180,
+	861,
+	860,
+	257,
+	256,
+	9,
+	18,
+	17,
+	16,
+	15,
+	255,
+	859,
+	858,
+	857,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	134,
+	254,
+	253,
+	252,
+	438,
+	437,
+	436,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	844,
+	843,
+	435,
+	434,
+	842,
+	841,
+	840,
+	839,
+	433,
+	838,
+	837,
+	836,
+	835,
+	834,
+	833,
+	251,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	826,
+	825,
+	824,
+	823,
+	822,
+	821,
+	820,
+	4,
+	78,
+	77,
+	76,
+	75,
+	432,
+	819,
+	818,
+	817,
+	816,
+	815,
+	814,
+	28,
+	103,
+	102,
+	101,
+	250,
+	249,
+	431,
+	813,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	430,
+	429,
+	803,
+	802,
+	428,
+	427,
+	426,
+	133,
+	801,
+	800,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	425,
+	424,
+	792,
+	791,
+	132,
+	790,
+	789,
+	788,
+	423,
+	422,
+	421,
+	787,
+	786,
+	785,
+	784,
+	783,
+	782,
+	420,
+	419,
+	418,
+	417,
+	248,
+	781,
+	780,
+	779,
+	416,
+	415,
+	414,
+	778,
+	777,
+	776,
+	775,
+	3,
+	179,
+	178,
+	177,
+	176,
+	74,
+	247,
+	246,
+	245,
+	175,
+	413,
+	412,
+	411,
+	410,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	174,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	757,
+	756,
+	31,
+	131,
+	130,
+	129,
+	73,
+	72,
+	71,
+	755,
+	754,
+	753,
+	752,
+	50,
+	128,
+	127,
+	126,
+	751,
+	750,
+	749,
+	748,
+	747,
+	746,
+	244,
+	745,
+	744,
+	743,
+	742,
+	741,
+	740,
+	243,
+	409,
+	408,
+	407,
+	739,
+	738,
+	737,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	125,
+	173,
+	728,
+	727,
+	726,
+	242,
+	241,
+	240,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	717,
+	239,
+	238,
+	406,
+	405,
+	404,
+	716,
+	715,
+	714,
+	713,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	706,
+	705,
+	704,
+	30,
+	403,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	172,
+	237,
+	236,
+	235,
+	697,
+	696,
+	695,
+	694,
+	693,
+	692,
+	691,
+	171,
+	170,
+	169,
+	168,
+	167,
+	166,
+	234,
+	233,
+	690,
+	689,
+	402,
+	401,
+	400,
+	399,
+	398,
+	21,
+	397,
+	396,
+	395,
+	394,
+	688,
+	687,
+	686,
+	685,
+	29,
+	393,
+	684,
+	683,
+	682,
+	681,
+	165,
+	232,
+	231,
+	680,
+	679,
+	678,
+	677,
+	676,
+	164,
+	163,
+	162,
+	161,
+	160,
+	230,
+	675,
+	392,
+	391,
+	390,
+	389,
+}
+
+// Profile is the pre-built french language profile.
+var Profile = langdet.Language{
+	Name:    "french",
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse("fr"),
+	Scripts: []langdet.Script{langdet.ScriptLatin},
+}
@@ -0,0 +1,1854 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/hebrew.txt; DO NOT EDIT.
+
+// Package he provides the pre-built hebrew langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package he
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+	"____\xd7",
+	"___\xd7",
+	"___א",
+	"___ב",
+	"___ג",
+	"___ד",
+	"___ה",
+	"___ו",
+	"___ז",
+	"___ח",
+	"___ט",
+	"___י",
+	"___כ",
+	"___ל",
+	"___מ",
+	"___נ",
+	"___ס",
+	"___ע",
+	"___פ",
+	"___ק",
+	"___ר",
+	"___ש",
+	"___ת",
+	"__\xd7",
+	"__א",
+	"__א\xd7",
+	"__ב",
+	"__ב\xd7",
+	"__ג",
+	"__ג\xd7",
+	"__ד",
+	"__ד\xd7",
+	"__ה",
+	"__ה\xd7",
+	"__ו",
+	"__ו\xd7",
+	"__ז",
+	"__ז\xd7",
+	"__ח",
+	"__ח\xd7",
+	"__ט",
+	"__ט\xd7",
+	"__י",
+	"__י\xd7",
+	"__כ",
+	"__כ\xd7",
+	"__ל",
+	"__ל\xd7",
+	"__מ",
+	"__מ\xd7",
+	"__נ",
+	"__נ\xd7",
+	"__ס",
+	"__ס\xd7",
+	"__ע",
+	"__ע\xd7",
+	"__פ",
+	"__פ\xd7",
+	"__ק",
+	"__ק\xd7",
+	"__ר",
+	"__ר\xd7",
+	"__ש",
+	"__ש\xd7",
+	"__ת",
+	"__ת\xd7",
+	"_\xd7",
+	"_א",
+	"_א\xd7",
+	"_או",
+	"_אי",
+	"_אפ",
+	"_אצ",
+	"_את",
+	"_ב",
+	"_ב\xd7",
+	"_בב",
+	"_בו",
+	"_בי",
+	"_בכ",
+	"_בק",
+	"_בת",
+	"_ג",
+	"_ג\xd7",
+	"_גד",
+	"_גם",
+	"_ד",
+	"_ד\xd7",
+	"_דו",
+	"_די",
+	"_דף",
+	"_ה",
+	"_ה\xd7",
+	"_הא",
+	"_הז",
+	"_הל",
+	"_המ",
+	"_הנ",
+	"_ו",
+	"_ו\xd7",
+	"_וג",
+	"_ול",
+	"_ומ",
+	"_ז",
+	"_ז\xd7",
+	"_זי",
+	"_ח",
+	"_ח\xd7",
+	"_חד",
+	"_חי",
+	"_ט",
+	"_ט\xd7",
+	"_טב",
+	"_טו",
+	"_טק",
+	"_י",
+	"_י\xd7",
+	"_יד",
+	"_יו",
+	"_יכ",
+	"_יצ",
+	"_כ",
+	"_כ\xd7",
+	"_כת",
+	"_ל",
+	"_ל\xd7",
+	"_לב",
+	"_לה",
+	"_לח",
+	"_לט",
+	"_לנ",
+	"_לפ",
+	"_מ",
+	"_מ\xd7",
+	"_מא",
+	"_מה",
+	"_מו",
+	"_מכ",
+	"_מנ",
+	"_מס",
+	"_מע",
+	"_מש",
+	"_נ",
+	"_נ\xd7",
+	"_נג",
+	"_נת",
+	"_ס",
+	"_ס\xd7",
+	"_סב",
+	"_ספ",
+	"_ע",
+	"_ע\xd7",
+	"_עב",
+	"_על",
+	"_פ",
+	"_פ\xd7",
+	"_פו",
+	"_ק",
+	"_ק\xd7",
+	"_קו",
+	"_קט",
+	"_קצ",
+	"_ר",
+	"_ר\xd7",
+	"_רב",
+	"_רע",
+	"_רצ",
+	"_ש",
+	"_ש\xd7",
+	"_שה",
+	"_של",
+	"_שפ",
+	"_ת",
+	"_ת\xd7",
+	"_תב",
+	"\x90",
+	"\x90\xd7",
+	"\x90ו",
+	"\x90ו\xd7",
+	"\x90וד",
+	"\x90ות",
+	"\x90י",
+	"\x90י\xd7",
+	"\x90ינ",
+	"\x90פ",
+	"\x90פ\xd7",
+	"\x90פי",
+	"\x90צ",
+	"\x90צ\xd7",
+	"\x90צב",
+	"\x90ת",
+	"\x90ת_",
+	"\x90ת__",
+	"\x91",
+	"\x91\xd7",
+	"\x91ב",
+	"\x91ב\xd7",
+	"\x91בי",
+	"\x91ו",
+	"\x91ו\xd7",
+	"\x91וד",
+	"\x91ור",
+	"\x91ות",
+	"\x91י",
+	"\x91י\xd7",
+	"\x91יו",
+	"\x91יט",
+	"\x91יע",
+	"\x91יר",
+	"\x91כ",
+	"\x91כ\xd7",
+	"\x91כל",
+	"\x91נ",
+	"\x91נ\xd7",
+	"\x91נו",
+	"\x91ני",
+	"\x91ק",
+	"\x91ק\xd7",
+	"\x91קט",
+	"\x91ת",
+	"\x91ת\xd7",
+	"\x91תד",
+	"\x91תי",
+	"\x92",
+	"\x92\xd7",
+	"\x92ד",
+	"\x92ד\xd7",
+	"\x92דו",
+	"\x92ם",
+	"\x92ם_",
+	"\x92ם__",
+	"\x92ר",
+	"\x92ר\xd7",
+	"\x92רמ",
+	"\x93",
+	"\x93\xd7",
+	"\x93ו",
+	"\x93ו\xd7",
+	"\x93וג",
+	"\x93ול",
+	"\x93י",
+	"\x93י\xd7",
+	"\x93יר",
+	"\x93ף",
+	"\x93ף_",
+	"\x93ף__",
+	"\x94",
+	"\x94\xd7",
+	"\x94א",
+	"\x94א\xd7",
+	"\x94או",
+	"\x94אצ",
+	"\x94ו",
+	"\x94ו\xd7",
+	"\x94וק",
+	"\x94ז",
+	"\x94ז\xd7",
+	"\x94זו",
+	"\x94ל",
+	"\x94ל\xd7",
+	"\x94לל",
+	"\x94מ",
+	"\x94מ\xd7",
+	"\x94מע",
+	"\x94נ",
+	"\x94נ\xd7",
+	"\x94נג",
+	"\x94נו",
+	"\x94נפ",
+	"\x94נק",
+	"\x94ש",
+	"\x94ש\xd7",
+	"\x94שו",
+	"\x95",
+	"\x95\xd7",
+	"\x95ב",
+	"\x95ב\xd7",
+	"\x95בו",
+	"\x95ג",
+	"\x95ג\xd7",
+	"\x95גם",
+	"\x95גמ",
+	"\x95ד",
+	"\x95ד\xd7",
+	"\x95דד",
+	"\x95ט",
+	"\x95ט\xd7",
+	"\x95טי",
+	"\x95כ",
+	"\x95כ\xd7",
+	"\x95כר",
+	"\x95ל",
+	"\x95ל\xd7",
+	"\x95לא",
+	"\x95מ",
+	"\x95מ\xd7",
+	"\x95מי",
+	"\x95מפ",
+	"\x95מש",
+	"\x95ע",
+	"\x95ע\xd7",
+	"\x95על",
+	"\x95פ",
+	"\x95פ\xd7",
+	"\x95פי",
+	"\x95ק",
+	"\x95ק\xd7",
+	"\x95קל",
+	"\x95ת",
+	"\x95ת\xd7",
+	"\x95תו",
+	"\x95תי",
+	"\x96",
+	"\x96\xd7",
+	"\x96י",
+	"\x96י\xd7",
+	"\x96יה",
+	"\x97",
+	"\x97\xd7",
+	"\x97ד",
+	"\x97ד\xd7",
+	"\x97דש",
+	"\x97ז",
+	"\x97ז\xd7",
+	"\x97זו",
+	"\x97י",
+	"\x97י\xd7",
+	"\x97יפ",
+	"\x98",
+	"\x98\xd7",
+	"\x98ב",
+	"\x98ב\xd7",
+	"\x98בי",
+	"\x98ו",
+	"\x98ו\xd7",
+	"\x98וב",
+	"\x98ע",
+	"\x98ע\xd7",
+	"\x98עי",
+	"\x98ק",
+	"\x98ק\xd7",
+	"\x98קס",
+	"\x99",
+	"\x99\xd7",
+	"\x99ד",
+	"\x99ד\xd7",
+	"\x99די",
+	"\x99ה",
+	"\x99ה\xd7",
+	"\x99הו",
+	"\x99ו",
+	"\x99ו\xd7",
+	"\x99ומ",
+	"\x99ות",
+	"\x99ט",
+	"\x99ט\xd7",
+	"\x99טח",
+	"\x99כ",
+	"\x99כ\xd7",
+	"\x99כו",
+	"\x99נ",
+	"\x99נ\xd7",
+	"\x99נט",
+	"\x99פ",
+	"\x99פ\xd7",
+	"\x99פו",
+	"\x99צ",
+	"\x99צ\xd7",
+	"\x99צי",
+	"\x99ר",
+	"\x99ר\xd7",
+	"\x99רו",
+	"\x9b",
+	"\x9b\xd7",
+	"\x9bו",
+	"\x9bו\xd7",
+	"\x9bול",
+	"\x9bר",
+	"\x9bר\xd7",
+	"\x9bרי",
+	"\x9bת",
+	"\x9bת\xd7",
+	"\x9bתי",
+	"\x9c",
+	"\x9c\xd7",
+	"\x9cא",
+	"\x9cא\xd7",
+	"\x9cאח",
+	"\x9cב",
+	"\x9cב\xd7",
+	"\x9cבנ",
+	"\x9cה",
+	"\x9cה\xd7",
+	"\x9cהש",
+	"\x9cח",
+	"\x9cח\xd7",
+	"\x9cחז",
+	"\x9cט",
+	"\x9cט\xd7",
+	"\x9cטב",
+	"\x9cל",
+	"\x9cל\xd7",
+	"\x9cלו",
+	"\x9cנ",
+	"\x9cנ\xd7",
+	"\x9cנד",
+	"\x9cפ",
+	"\x9cפ\xd7",
+	"\x9cפר",
+	"\x9e",
+	"\x9e\xd7",
+	"\x9eא",
+	"\x9eא\xd7",
+	"\x9eאו",
+	"\x9eה",
+	"\x9eה\xd7",
+	"\x9eהנ",
+	"\x9eו",
+	"\x9eו\xd7",
+	"\x9eוכ",
+	"\x9eי",
+	"\x9eי\xd7",
+	"\x9eיו",
+	"\x9eכ",
+	"\x9eכ\xd7",
+	"\x9eכן",
+	"\x9eנ",
+	"\x9eנ\xd7",
+	"\x9eנת",
+	"\x9eס",
+	"\x9eס\xd7",
+	"\x9eספ",
+	"\x9eסת",
+	"\x9eע",
+	"\x9eע\xd7",
+	"\x9eער",
+	"\x9eפ",
+	"\x9eפ\xd7",
+	"\x9eפק",
+	"\x9eש",
+	"\x9eש\xd7",
+	"\x9eשו",
+	"\x9eשפ",
+	"\xa0",
+	"\xa0\xd7",
+	"\xa0ג",
+	"\xa0ג\xd7",
+	"\xa0גר",
+	"\xa0ד",
+	"\xa0ד\xd7",
+	"\xa0די",
+	"\xa0ו",
+	"\xa0ו\xd7",
+	"\xa0וט",
+	"\xa0ט",
+	"\xa0ט\xd7",
+	"\xa0טר",
+	"\xa0י",
+	"\xa0י\xd7",
+	"\xa0יו",
+	"\xa0פ",
+	"\xa0פ\xd7",
+	"\xa0פו",
+	"\xa0ק",
+	"\xa0ק\xd7",
+	"\xa0קר",
+	"\xa0ת",
+	"\xa0ת\xd7",
+	"\xa0תו",
+	"\xa0תח",
+	"\xa1",
+	"\xa1\xd7",
+	"\xa1ב",
+	"\xa1ב\xd7",
+	"\xa1בי",
+	"\xa1פ",
+	"\xa1פ\xd7",
+	"\xa1פי",
+	"\xa1פר",
+	"\xa1ת",
+	"\xa1ת\xd7",
+	"\xa1תמ",
+	"\xa2",
+	"\xa2\xd7",
+	"\xa2ב",
+	"\xa2ב\xd7",
+	"\xa2בו",
+	"\xa2י",
+	"\xa2י\xd7",
+	"\xa2יו",
+	"\xa2ל",
+	"\xa2ל_",
+	"\xa2ל__",
+	"\xa2ר",
+	"\xa2ר\xd7",
+	"\xa2רכ",
+	"\xa4",
+	"\xa4\xd7",
+	"\xa4ו",
+	"\xa4ו\xd7",
+	"\xa4וע",
+	"\xa4ות",
+	"\xa4י",
+	"\xa4י\xd7",
+	"\xa4יל",
+	"\xa4ק",
+	"\xa4ק\xd7",
+	"\xa4קט",
+	"\xa4ר",
+	"\xa4ר\xd7",
+	"\xa4רו",
+	"\xa6",
+	"\xa6\xd7",
+	"\xa6ב",
+	"\xa6ב\xd7",
+	"\xa6בע",
+	"\xa6י",
+	"\xa6י\xd7",
+	"\xa6יב",
+	"\xa6פ",
+	"\xa6פ\xd7",
+	"\xa6פי",
+	"\xa6ר",
+	"\xa6ר\xd7",
+	"\xa6רי",
+	"\xa7",
+	"\xa7\xd7",
+	"\xa7ו",
+	"\xa7ו\xd7",
+	"\xa7ומ",
+	"\xa7ט",
+	"\xa7ט\xd7",
+	"\xa7טע",
+	"\xa7ס",
+	"\xa7ס\xd7",
+	"\xa7סט",
+	"\xa7צ",
+	"\xa7צ\xd7",
+	"\xa7צר",
+	"\xa7ר",
+	"\xa7ר\xd7",
+	"\xa7רא",
+	"\xa8",
+	"\xa8\xd7",
+	"\xa8ב",
+	"\xa8ב\xd7",
+	"\xa8בו",
+	"\xa8ו",
+	"\xa8ו\xd7",
+	"\xa8ופ",
+	"\xa8כ",
+	"\xa8כ\xd7",
+	"\xa8כו",
+	"\xa8מ",
+	"\xa8מ\xd7",
+	"\xa8מי",
+	"\xa8ע",
+	"\xa8ע\xd7",
+	"\xa8עי",
+	"\xa8צ",
+	"\xa8צ\xd7",
+	"\xa8צפ",
+	"\xa9",
+	"\xa9\xd7",
+	"\xa9ה",
+	"\xa9ה\xd7",
+	"\xa9הו",
+	"\xa9ו",
+	"\xa9ו\xd7",
+	"\xa9וו",
+	"\xa9ל",
+	"\xa9ל_",
+	"\xa9ל__",
+	"\xa9ל\xd7",
+	"\xa9לו",
+	"\xa9פ",
+	"\xa9פ\xd7",
+	"\xa9פה",
+	"\xa9פו",
+	"\xa9פט",
+	"\xaa",
+	"\xaa\xd7",
+	"\xaaב",
+	"\xaaב\xd7",
+	"\xaaבנ",
+	"\xaaד",
+	"\xaaד\xd7",
+	"\xaaדי",
+	"\xaaו",
+	"\xaaו\xd7",
+	"\xaaונ",
+	"\xaaי",
+	"\xaaי\xd7",
+	"\xaaיב",
+	"\xaaיו",
+	"\xaaמ",
+	"\xaaמ\xd7",
+	"\xaaמכ",
+	"\xd7",
+	"א",
+	"א\xd7",
+	"או",
+	"או\xd7",
+	"אח",
+	"אח\xd7",
+	"אי",
+	"אי\xd7",
+	"אפ",
+	"אפ\xd7",
+	"אצ",
+	"אצ\xd7",
+	"את",
+	"את_",
+	"ב",
+	"ב\xd7",
+	"בב",
+	"בב\xd7",
+	"בו",
+	"בו\xd7",
+	"בי",
+	"בי\xd7",
+	"בכ",
+	"בכ\xd7",
+	"בנ",
+	"בנ\xd7",
+	"בק",
+	"בק\xd7",
+	"בת",
+	"בת\xd7",
+	"ג",
+	"ג\xd7",
+	"גד",
+	"גד\xd7",
+	"גם",
+	"גם_",
+	"גמ",
+	"גמ\xd7",
+	"גר",
+	"גר\xd7",
+	"ד",
+	"ד\xd7",
+	"דו",
+	"דו\xd7",
+	"די",
+	"די_",
+	"די\xd7",
+	"דף",
+	"דף_",
+	"דש",
+	"דש_",
+	"ה",
+	"ה\xd7",
+	"הא",
+	"הא\xd7",
+	"הו",
+	"הו\xd7",
+	"הז",
+	"הז\xd7",
+	"הל",
+	"הל\xd7",
+	"המ",
+	"המ\xd7",
+	"הנ",
+	"הנ\xd7",
+	"הש",
+	"הש\xd7",
+	"ו",
+	"ו\xd7",
+	"וב",
+	"וב\xd7",
+	"וג",
+	"וג\xd7",
+	"וד",
+	"וד\xd7",
+	"וט",
+	"וט\xd7",
+	"וכ",
+	"וכ\xd7",
+	"ול",
+	"ול\xd7",
+	"ומ",
+	"ומ\xd7",
+	"ונ",
+	"ונ\xd7",
+	"וע",
+	"וע\xd7",
+	"ופ",
+	"ופ\xd7",
+	"וק",
+	"וק\xd7",
+	"ות",
+	"ות\xd7",
+	"ז",
+	"ז\xd7",
+	"זו",
+	"זו_",
+	"זו\xd7",
+	"זי",
+	"זי\xd7",
+	"ח",
+	"ח\xd7",
+	"חד",
+	"חד\xd7",
+	"חז",
+	"חז\xd7",
+	"חי",
+	"חי\xd7",
+	"ט",
+	"ט\xd7",
+	"טב",
+	"טב\xd7",
+	"טו",
+	"טו\xd7",
+	"טח",
+	"טח\xd7",
+	"טע",
+	"טע_",
+	"טע\xd7",
+	"טק",
+	"טק\xd7",
+	"טר",
+	"טר\xd7",
+	"י",
+	"י\xd7",
+	"יב",
+	"יב\xd7",
+	"יד",
+	"יד\xd7",
+	"יה",
+	"יה\xd7",
+	"יו",
+	"יו\xd7",
+	"יט",
+	"יט\xd7",
+	"יכ",
+	"יכ\xd7",
+	"יל",
+	"יל\xd7",
+	"ינ",
+	"ינ\xd7",
+	"יע",
+	"יע\xd7",
+	"יפ",
+	"יפ\xd7",
+	"יצ",
+	"יצ\xd7",
+	"יר",
+	"יר\xd7",
+	"כ",
+	"כ\xd7",
+	"כו",
+	"כו\xd7",
+	"כל",
+	"כל_",
+	"כן",
+	"כן_",
+	"כר",
+	"כר\xd7",
+	"כת",
+	"כת\xd7",
+	"ל",
+	"ל\xd7",
+	"לא",
+	"לא\xd7",
+	"לב",
+	"לב\xd7",
+	"לה",
+	"לה\xd7",
+	"לו",
+	"לו_",
+	"לח",
+	"לח\xd7",
+	"לט",
+	"לט\xd7",
+	"לל",
+	"לל\xd7",
+	"לנ",
+	"לנ\xd7",
+	"לפ",
+	"לפ\xd7",
+	"מ",
+	"מ\xd7",
+	"מא",
+	"מא\xd7",
+	"מה",
+	"מה\xd7",
+	"מו",
+	"מו\xd7",
+	"מי",
+	"מי\xd7",
+	"מכ",
+	"מכ\xd7",
+	"מנ",
+	"מנ\xd7",
+	"מס",
+	"מס\xd7",
+	"מע",
+	"מע\xd7",
+	"מפ",
+	"מפ\xd7",
+	"מש",
+	"מש\xd7",
+	"נ",
+	"נ\xd7",
+	"נג",
+	"נג\xd7",
+	"נד",
+	"נד\xd7",
+	"נו",
+	"נו\xd7",
+	"נט",
+	"נט\xd7",
+	"ני",
+	"ני\xd7",
+	"נפ",
+	"נפ\xd7",
+	"נק",
+	"נק\xd7",
+	"נת",
+	"נת\xd7",
+	"ס",
+	"ס\xd7",
+	"סב",
+	"סב\xd7",
+	"ספ",
+	"ספ\xd7",
+	"סת",
+	"סת\xd7",
+	"ע",
+	"ע\xd7",
+	"עב",
+	"עב\xd7",
+	"עי",
+	"עי\xd7",
+	"על",
+	"על_",
+	"ער",
+	"ער\xd7",
+	"פ",
+	"פ\xd7",
+	"פה",
+	"פה_",
+	"פו",
+	"פו\xd7",
+	"פי",
+	"פי\xd7",
+	"פק",
+	"פק\xd7",
+	"פר",
+	"פר_",
+	"פר\xd7",
+	"צ",
+	"צ\xd7",
+	"צב",
+	"צב\xd7",
+	"צי",
+	"צי\xd7",
+	"צפ",
+	"צפ\xd7",
+	"צר",
+	"צר_",
+	"צר\xd7",
+	"ק",
+	"ק\xd7",
+	"קו",
+	"קו\xd7",
+	"קט",
+	"קט\xd7",
+	"קס",
+	"קס\xd7",
+	"קצ",
+	"קצ\xd7",
+	"קר",
+	"קר\xd7",
+	"ר",
+	"ר\xd7",
+	"רא",
+	"רא\xd7",
+	"רב",
+	"רב\xd7",
+	"רו",
+	"רו\xd7",
+	"רי",
+	"רי\xd7",
+	"רכ",
+	"רכ\xd7",
+	"רמ",
+	"רמ\xd7",
+	"רע",
+	"רע\xd7",
+	"רצ",
+	"רצ\xd7",
+	"ש",
+	"ש\xd7",
+	"שה",
+	"שה\xd7",
+	"שו",
+	"שו\xd7",
+	"של",
+	"של_",
+	"של\xd7",
+	"שפ",
+	"שפ\xd7",
+	"ת",
+	"ת\xd7",
+	"תב",
+	"תב\xd7",
+	"תד",
+	"תד\xd7",
+	"תו",
+	"תו\xd7",
+	"תי",
+	"תי\xd7",
+	"תמ",
+	"תמ\xd7",
+}
+
+var ranks = []uint16{
+	5,
+	4,
+	108,
+	79,
+	237,
+	236,
+	78,
+	235,
+	404,
+	403,
+	173,
+	172,
+	915,
+	107,
+	59,
+	402,
+	401,
+	141,
+	914,
+	140,
+	234,
+	40,
+	913,
+	3,
+	106,
+	105,
+	77,
+	76,
+	233,
+	232,
+	231,
+	230,
+	75,
+	74,
+	229,
+	228,
+	400,
+	399,
+	398,
+	397,
+	171,
+	170,
+	169,
+	168,
+	912,
+	911,
+	104,
+	103,
+	58,
+	57,
+	396,
+	395,
+	394,
+	393,
+	139,
+	138,
+	910,
+	909,
+	137,
+	136,
+	227,
+	226,
+	39,
+	38,
+	908,
+	907,
+	2,
+	102,
+	101,
+	392,
+	906,
+	905,
+	904,
+	903,
+	73,
+	72,
+	902,
+	901,
+	391,
+	900,
+	899,
+	390,
+	225,
+	224,
+	898,
+	389,
+	223,
+	222,
+	897,
+	896,
+	895,
+	71,
+	70,
+	388,
+	894,
+	893,
+	892,
+	221,
+	220,
+	219,
+	891,
+	890,
+	889,
+	387,
+	386,
+	385,
+	384,
+	383,
+	888,
+	887,
+	167,
+	166,
+	886,
+	885,
+	382,
+	165,
+	164,
+	884,
+	883,
+	882,
+	881,
+	880,
+	879,
+	878,
+	100,
+	99,
+	877,
+	876,
+	875,
+	874,
+	873,
+	872,
+	56,
+	55,
+	871,
+	870,
+	869,
+	868,
+	867,
+	381,
+	866,
+	865,
+	380,
+	379,
+	864,
+	863,
+	378,
+	377,
+	862,
+	861,
+	135,
+	134,
+	218,
+	376,
+	860,
+	859,
+	858,
+	133,
+	132,
+	857,
+	856,
+	217,
+	216,
+	215,
+	855,
+	854,
+	853,
+	37,
+	36,
+	852,
+	98,
+	163,
+	851,
+	850,
+	849,
+	54,
+	53,
+	162,
+	161,
+	848,
+	214,
+	847,
+	846,
+	845,
+	844,
+	843,
+	842,
+	375,
+	374,
+	373,
+	841,
+	840,
+	839,
+	13,
+	12,
+	838,
+	837,
+	836,
+	131,
+	130,
+	835,
+	213,
+	834,
+	97,
+	96,
+	372,
+	833,
+	371,
+	832,
+	831,
+	830,
+	829,
+	370,
+	369,
+	828,
+	827,
+	826,
+	825,
+	824,
+	368,
+	367,
+	823,
+	822,
+	129,
+	128,
+	821,
+	820,
+	819,
+	366,
+	365,
+	364,
+	363,
+	362,
+	361,
+	127,
+	126,
+	360,
+	359,
+	818,
+	817,
+	358,
+	357,
+	356,
+	816,
+	815,
+	814,
+	35,
+	34,
+	355,
+	354,
+	813,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	803,
+	802,
+	801,
+	800,
+	160,
+	159,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	15,
+	14,
+	792,
+	791,
+	790,
+	353,
+	352,
+	789,
+	788,
+	787,
+	786,
+	785,
+	784,
+	783,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	776,
+	212,
+	211,
+	775,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	210,
+	209,
+	763,
+	351,
+	350,
+	349,
+	348,
+	347,
+	346,
+	208,
+	207,
+	762,
+	761,
+	760,
+	759,
+	758,
+	757,
+	756,
+	755,
+	754,
+	95,
+	94,
+	345,
+	344,
+	343,
+	753,
+	752,
+	751,
+	750,
+	749,
+	748,
+	342,
+	341,
+	340,
+	29,
+	28,
+	747,
+	746,
+	745,
+	339,
+	338,
+	337,
+	158,
+	157,
+	336,
+	335,
+	744,
+	743,
+	742,
+	741,
+	740,
+	739,
+	738,
+	737,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	728,
+	727,
+	206,
+	205,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	69,
+	68,
+	717,
+	716,
+	715,
+	714,
+	713,
+	712,
+	711,
+	710,
+	709,
+	708,
+	707,
+	706,
+	705,
+	704,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	696,
+	695,
+	694,
+	27,
+	26,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	686,
+	685,
+	684,
+	683,
+	682,
+	681,
+	680,
+	679,
+	678,
+	677,
+	676,
+	334,
+	333,
+	675,
+	674,
+	332,
+	331,
+	330,
+	673,
+	672,
+	671,
+	329,
+	328,
+	670,
+	669,
+	44,
+	43,
+	327,
+	326,
+	325,
+	668,
+	667,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	654,
+	653,
+	652,
+	651,
+	324,
+	323,
+	650,
+	649,
+	156,
+	155,
+	648,
+	647,
+	646,
+	322,
+	321,
+	645,
+	644,
+	643,
+	642,
+	641,
+	67,
+	66,
+	204,
+	203,
+	202,
+	640,
+	639,
+	638,
+	320,
+	319,
+	318,
+	317,
+	316,
+	315,
+	125,
+	124,
+	314,
+	313,
+	637,
+	636,
+	635,
+	634,
+	633,
+	632,
+	631,
+	630,
+	629,
+	628,
+	627,
+	123,
+	122,
+	626,
+	625,
+	624,
+	623,
+	622,
+	621,
+	620,
+	619,
+	618,
+	312,
+	311,
+	310,
+	52,
+	51,
+	617,
+	616,
+	615,
+	309,
+	308,
+	307,
+	306,
+	305,
+	304,
+	201,
+	200,
+	199,
+	614,
+	613,
+	612,
+	93,
+	92,
+	611,
+	610,
+	609,
+	608,
+	607,
+	606,
+	605,
+	604,
+	603,
+	602,
+	601,
+	600,
+	599,
+	598,
+	597,
+	596,
+	595,
+	594,
+	21,
+	20,
+	593,
+	592,
+	591,
+	303,
+	302,
+	301,
+	91,
+	121,
+	120,
+	590,
+	589,
+	119,
+	118,
+	198,
+	588,
+	587,
+	85,
+	84,
+	586,
+	585,
+	584,
+	583,
+	582,
+	581,
+	580,
+	579,
+	578,
+	197,
+	196,
+	300,
+	577,
+	576,
+	575,
+	574,
+	1,
+	42,
+	41,
+	154,
+	153,
+	573,
+	572,
+	571,
+	570,
+	569,
+	568,
+	299,
+	298,
+	567,
+	566,
+	11,
+	10,
+	565,
+	564,
+	90,
+	89,
+	88,
+	87,
+	563,
+	562,
+	297,
+	296,
+	561,
+	560,
+	295,
+	294,
+	83,
+	82,
+	559,
+	558,
+	195,
+	194,
+	557,
+	556,
+	293,
+	292,
+	65,
+	64,
+	291,
+	290,
+	152,
+	555,
+	193,
+	554,
+	553,
+	552,
+	551,
+	25,
+	24,
+	289,
+	288,
+	192,
+	191,
+	550,
+	549,
+	548,
+	547,
+	546,
+	545,
+	151,
+	150,
+	544,
+	543,
+	7,
+	6,
+	542,
+	541,
+	287,
+	286,
+	540,
+	539,
+	538,
+	537,
+	536,
+	535,
+	190,
+	189,
+	188,
+	187,
+	534,
+	533,
+	532,
+	531,
+	530,
+	529,
+	528,
+	527,
+	117,
+	116,
+	149,
+	148,
+	285,
+	526,
+	525,
+	284,
+	283,
+	186,
+	185,
+	524,
+	523,
+	522,
+	521,
+	520,
+	519,
+	50,
+	49,
+	282,
+	281,
+	518,
+	517,
+	516,
+	515,
+	280,
+	514,
+	513,
+	279,
+	278,
+	512,
+	511,
+	9,
+	8,
+	184,
+	183,
+	510,
+	509,
+	277,
+	276,
+	115,
+	114,
+	508,
+	507,
+	506,
+	505,
+	504,
+	503,
+	502,
+	501,
+	500,
+	499,
+	498,
+	497,
+	496,
+	495,
+	275,
+	274,
+	113,
+	112,
+	494,
+	493,
+	492,
+	491,
+	490,
+	489,
+	488,
+	487,
+	486,
+	485,
+	48,
+	47,
+	484,
+	483,
+	482,
+	481,
+	480,
+	479,
+	478,
+	477,
+	476,
+	475,
+	474,
+	473,
+	472,
+	471,
+	470,
+	469,
+	468,
+	467,
+	19,
+	18,
+	466,
+	465,
+	464,
+	463,
+	462,
+	461,
+	460,
+	459,
+	273,
+	272,
+	458,
+	457,
+	271,
+	270,
+	269,
+	268,
+	456,
+	455,
+	267,
+	266,
+	33,
+	32,
+	265,
+	264,
+	454,
+	453,
+	263,
+	262,
+	452,
+	451,
+	450,
+	449,
+	448,
+	447,
+	446,
+	445,
+	261,
+	260,
+	147,
+	146,
+	444,
+	443,
+	259,
+	258,
+	442,
+	441,
+	63,
+	62,
+	182,
+	181,
+	440,
+	439,
+	257,
+	256,
+	255,
+	254,
+	23,
+	22,
+	180,
+	179,
+	178,
+	177,
+	145,
+	144,
+	438,
+	437,
+	253,
+	436,
+	435,
+	81,
+	80,
+	252,
+	251,
+	434,
+	433,
+	432,
+	431,
+	176,
+	430,
+	250,
+	46,
+	45,
+	429,
+	428,
+	249,
+	248,
+	247,
+	246,
+	175,
+	174,
+	427,
+	426,
+	31,
+	30,
+	425,
+	424,
+	423,
+	422,
+	245,
+	244,
+	243,
+	242,
+	421,
+	420,
+	241,
+	240,
+	419,
+	418,
+	417,
+	416,
+	17,
+	16,
+	415,
+	414,
+	239,
+	238,
+	86,
+	111,
+	413,
+	110,
+	109,
+	61,
+	60,
+	412,
+	411,
+	410,
+	409,
+	408,
+	407,
+	143,
+	142,
+	406,
+	405,
+}
+
+// Profile is the pre-built hebrew language profile.
+var Profile = langdet.Language{
+	Name:    "hebrew",
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse("he"),
+	Scripts: []langdet.Script{langdet.ScriptHebrew},
+}
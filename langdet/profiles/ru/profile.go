@@ -0,0 +1,2188 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/russian.txt; DO NOT EDIT.
+
+// Package ru provides the pre-built russian langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package ru
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+	"____n",
+	"____\xd0",
+	"____\xd1",
+	"___n",
+	"___n_",
+	"___\xd0",
+	"___О",
+	"___Р",
+	"___Т",
+	"___Х",
+	"___а",
+	"___б",
+	"___в",
+	"___г",
+	"___д",
+	"___е",
+	"___ж",
+	"___з",
+	"___и",
+	"___к",
+	"___м",
+	"___н",
+	"___о",
+	"___п",
+	"___\xd1",
+	"___р",
+	"___с",
+	"___т",
+	"___у",
+	"___ф",
+	"___ч",
+	"___э",
+	"___я",
+	"__n",
+	"__n_",
+	"__n__",
+	"__\xd0",
+	"__О",
+	"__О\xd0",
+	"__Р",
+	"__Р\xd0",
+	"__Т",
+	"__Т\xd0",
+	"__Х",
+	"__Х\xd0",
+	"__а",
+	"__а\xd0",
+	"__б",
+	"__б\xd0",
+	"__б\xd1",
+	"__в",
+	"__в_",
+	"__в\xd0",
+	"__г",
+	"__г\xd1",
+	"__д",
+	"__д\xd0",
+	"__е",
+	"__е\xd0",
+	"__ж",
+	"__ж\xd0",
+	"__з",
+	"__з\xd0",
+	"__и",
+	"__и_",
+	"__и\xd0",
+	"__к",
+	"__к_",
+	"__к\xd0",
+	"__м",
+	"__м\xd0",
+	"__н",
+	"__н\xd0",
+	"__о",
+	"__о\xd0",
+	"__о\xd1",
+	"__п",
+	"__п\xd0",
+	"__п\xd1",
+	"__\xd1",
+	"__р",
+	"__р\xd0",
+	"__с",
+	"__с_",
+	"__с\xd0",
+	"__с\xd1",
+	"__т",
+	"__т\xd0",
+	"__у",
+	"__у\xd0",
+	"__ф",
+	"__ф\xd1",
+	"__ч",
+	"__ч\xd0",
+	"__э",
+	"__э\xd1",
+	"__я",
+	"__я\xd0",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_\xd0",
+	"_О",
+	"_О\xd0",
+	"_Оп",
+	"_Р",
+	"_Р\xd0",
+	"_Ра",
+	"_Т",
+	"_Т\xd0",
+	"_Та",
+	"_Х",
+	"_Х\xd0",
+	"_Хо",
+	"_а",
+	"_а\xd0",
+	"_ан",
+	"_б",
+	"_б\xd0",
+	"_бо",
+	"_б\xd1",
+	"_бу",
+	"_в",
+	"_в_",
+	"_в__",
+	"_в\xd0",
+	"_вв",
+	"_ве",
+	"_г",
+	"_г\xd1",
+	"_гр",
+	"_д",
+	"_д\xd0",
+	"_да",
+	"_дл",
+	"_до",
+	"_е",
+	"_е\xd0",
+	"_ег",
+	"_ж",
+	"_ж\xd0",
+	"_же",
+	"_з",
+	"_з\xd0",
+	"_за",
+	"_и",
+	"_и_",
+	"_и__",
+	"_и\xd0",
+	"_ид",
+	"_из",
+	"_к",
+	"_к_",
+	"_к__",
+	"_к\xd0",
+	"_кн",
+	"_ко",
+	"_м",
+	"_м\xd0",
+	"_мн",
+	"_мо",
+	"_н",
+	"_н\xd0",
+	"_на",
+	"_но",
+	"_о",
+	"_о\xd0",
+	"_об",
+	"_од",
+	"_оп",
+	"_о\xd1",
+	"_от",
+	"_оч",
+	"_п",
+	"_п\xd0",
+	"_по",
+	"_п\xd1",
+	"_пр",
+	"_\xd1",
+	"_р",
+	"_р\xd0",
+	"_ра",
+	"_ре",
+	"_с",
+	"_с_",
+	"_с__",
+	"_с\xd0",
+	"_са",
+	"_си",
+	"_со",
+	"_с\xd1",
+	"_ср",
+	"_ст",
+	"_т",
+	"_т\xd0",
+	"_те",
+	"_у",
+	"_у\xd0",
+	"_ув",
+	"_ф",
+	"_ф\xd1",
+	"_фр",
+	"_ч",
+	"_ч\xd0",
+	"_ча",
+	"_э",
+	"_э\xd1",
+	"_эт",
+	"_я",
+	"_я\xd0",
+	"_яз",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"\x80",
+	"\x80\xd0",
+	"\x80а",
+	"\x80а\xd0",
+	"\x80аб",
+	"\x80ав",
+	"\x80аз",
+	"\x80ам",
+	"\x80ан",
+	"\x80а\xd1",
+	"\x80аю",
+	"\x80е",
+	"\x80е\xd0",
+	"\x80ед",
+	"\x80ен",
+	"\x80о",
+	"\x80о\xd0",
+	"\x80ок",
+	"\x80о\xd1",
+	"\x80от",
+	"\x80оф",
+	"\x80ош",
+	"\x80\xd1",
+	"\x80ы",
+	"\x80ы\xd0",
+	"\x80ыв",
+	"\x81",
+	"\x81\xd0",
+	"\x81а",
+	"\x81а\xd0",
+	"\x81ам",
+	"\x81е",
+	"\x81е\xd0",
+	"\x81ед",
+	"\x81и",
+	"\x81и\xd1",
+	"\x81ис",
+	"\x81л",
+	"\x81л\xd0",
+	"\x81ле",
+	"\x81о",
+	"\x81о_",
+	"\x81о__",
+	"\x81\xd1",
+	"\x81р",
+	"\x81р\xd0",
+	"\x81ра",
+	"\x81т",
+	"\x81т\xd0",
+	"\x81та",
+	"\x81те",
+	"\x81тн",
+	"\x81то",
+	"\x81т\xd1",
+	"\x81тр",
+	"\x81ты",
+	"\x82",
+	"\x82\xd0",
+	"\x82а",
+	"\x82а\xd0",
+	"\x82аб",
+	"\x82а\xd1",
+	"\x82ат",
+	"\x82е",
+	"\x82е\xd0",
+	"\x82ек",
+	"\x82и",
+	"\x82и\xd0",
+	"\x82им",
+	"\x82о",
+	"\x82о\xd1",
+	"\x82ор",
+	"\x82от",
+	"\x82п",
+	"\x82п\xd0",
+	"\x82пе",
+	"\x82\xd1",
+	"\x82р",
+	"\x82р\xd0",
+	"\x82ра",
+	"\x82ро",
+	"\x82р\xd1",
+	"\x82ры",
+	"\x83",
+	"\x83\xd0",
+	"\x83в",
+	"\x83в\xd0",
+	"\x83ве",
+	"\x83к",
+	"\x83к\xd0",
+	"\x83кв",
+	"\x83м",
+	"\x83м\xd0",
+	"\x83мн",
+	"\x84",
+	"\x84\xd0",
+	"\x84и",
+	"\x84и\xd0",
+	"\x84ил",
+	"\x84\xd1",
+	"\x84р",
+	"\x84р\xd0",
+	"\x84ра",
+	"\x87",
+	"\x87\xd0",
+	"\x87а",
+	"\x87а\xd1",
+	"\x87ас",
+	"\x87ат",
+	"\x87е",
+	"\x87е\xd0",
+	"\x87ен",
+	"\x8b",
+	"\x8b\xd0",
+	"\x8bв",
+	"\x8bв\xd0",
+	"\x8bва",
+	"\x8bвк",
+	"\x8bк",
+	"\x8bк\xd0",
+	"\x8bко",
+	"\x8d",
+	"\x8d\xd1",
+	"\x8dт",
+	"\x8dт\xd0",
+	"\x8dти",
+	"\x8f",
+	"\x8f\xd0",
+	"\x8fз",
+	"\x8fз\xd1",
+	"\x8fзы",
+	"\x9e",
+	"\x9e\xd0",
+	"\x9eп",
+	"\x9eп\xd1",
+	"\x9eпр",
+	"\xa0",
+	"\xa0\xd0",
+	"\xa0а",
+	"\xa0а\xd0",
+	"\xa0ан",
+	"\xa2",
+	"\xa2\xd0",
+	"\xa2а",
+	"\xa2а_",
+	"\xa2а__",
+	"\xa5",
+	"\xa5\xd0",
+	"\xa5о",
+	"\xa5о\xd1",
+	"\xa5ор",
+	"\xb0",
+	"\xb0\xd0",
+	"\xb0б",
+	"\xb0б\xd0",
+	"\xb0би",
+	"\xb0бо",
+	"\xb0в",
+	"\xb0в\xd0",
+	"\xb0вн",
+	"\xb0ж",
+	"\xb0ж\xd0",
+	"\xb0же",
+	"\xb0з",
+	"\xb0з\xd1",
+	"\xb0зу",
+	"\xb0зы",
+	"\xb0к",
+	"\xb0к\xd1",
+	"\xb0кт",
+	"\xb0л",
+	"\xb0л\xd0",
+	"\xb0ли",
+	"\xb0м",
+	"\xb0м\xd0",
+	"\xb0мм",
+	"\xb0м\xd1",
+	"\xb0мы",
+	"\xb0н",
+	"\xb0н\xd0",
+	"\xb0на",
+	"\xb0нж",
+	"\xb0ни",
+	"\xb0нн",
+	"\xb0\xd1",
+	"\xb0с",
+	"\xb0с\xd1",
+	"\xb0ст",
+	"\xb0т",
+	"\xb0т\xd0",
+	"\xb0те",
+	"\xb0то",
+	"\xb1",
+	"\xb1\xd0",
+	"\xb1и",
+	"\xb1и\xd0",
+	"\xb1ил",
+	"\xb1о",
+	"\xb1о\xd0",
+	"\xb1ол",
+	"\xb1о\xd1",
+	"\xb1от",
+	"\xb1\xd1",
+	"\xb1р",
+	"\xb1р\xd0",
+	"\xb1ра",
+	"\xb1у",
+	"\xb1у\xd0",
+	"\xb1ук",
+	"\xb2",
+	"\xb2\xd0",
+	"\xb2а",
+	"\xb2а\xd0",
+	"\xb2ае",
+	"\xb2а\xd1",
+	"\xb2ат",
+	"\xb2в",
+	"\xb2в\xd0",
+	"\xb2ве",
+	"\xb2е",
+	"\xb2е\xd0",
+	"\xb2еб",
+	"\xb2ед",
+	"\xb2ен",
+	"\xb2е\xd1",
+	"\xb2ер",
+	"\xb2ес",
+	"\xb2н",
+	"\xb2н\xd0",
+	"\xb2ни",
+	"\xb2\xd1",
+	"\xb2с",
+	"\xb2с\xd0",
+	"\xb2се",
+	"\xb2т",
+	"\xb2т\xd0",
+	"\xb2то",
+	"\xb3",
+	"\xb3\xd1",
+	"\xb3р",
+	"\xb3р\xd0",
+	"\xb3ра",
+	"\xb4",
+	"\xb4\xd0",
+	"\xb4а",
+	"\xb4а\xd0",
+	"\xb4аж",
+	"\xb4ан",
+	"\xb4е",
+	"\xb4е\xd0",
+	"\xb4ел",
+	"\xb4е\xd1",
+	"\xb4ея",
+	"\xb4к",
+	"\xb4к\xd0",
+	"\xb4ки",
+	"\xb4л",
+	"\xb4л\xd1",
+	"\xb4ля",
+	"\xb4н",
+	"\xb4н\xd0",
+	"\xb4не",
+	"\xb4но",
+	"\xb4о",
+	"\xb4о\xd0",
+	"\xb4ов",
+	"\xb4о\xd1",
+	"\xb4ос",
+	"\xb4\xd1",
+	"\xb4ё",
+	"\xb4ё\xd0",
+	"\xb4ён",
+	"\xb5",
+	"\xb5\xd0",
+	"\xb5г",
+	"\xb5г\xd0",
+	"\xb5го",
+	"\xb5д",
+	"\xb5д\xd0",
+	"\xb5де",
+	"\xb5дк",
+	"\xb5дн",
+	"\xb5до",
+	"\xb5д\xd1",
+	"\xb5дё",
+	"\xb5к",
+	"\xb5к\xd1",
+	"\xb5кс",
+	"\xb5н",
+	"\xb5н\xd0",
+	"\xb5нн",
+	"\xb5\xd1",
+	"\xb5р",
+	"\xb5р\xd0",
+	"\xb5ре",
+	"\xb5с",
+	"\xb5с\xd1",
+	"\xb5ст",
+	"\xb5ч",
+	"\xb5ч\xd0",
+	"\xb5ча",
+	"\xb6",
+	"\xb6\xd0",
+	"\xb6е",
+	"\xb6е_",
+	"\xb6е__",
+	"\xb6и",
+	"\xb6и\xd1",
+	"\xb6ир",
+	"\xb7",
+	"\xb7\xd0",
+	"\xb7а",
+	"\xb7а\xd1",
+	"\xb7ат",
+	"\xb7в",
+	"\xb7в\xd0",
+	"\xb7ве",
+	"\xb7\xd1",
+	"\xb7у",
+	"\xb7у\xd0",
+	"\xb7ум",
+	"\xb7ы",
+	"\xb7ы\xd0",
+	"\xb7ыв",
+	"\xb7ык",
+	"\xb8",
+	"\xb8\xd0",
+	"\xb8д",
+	"\xb8д\xd0",
+	"\xb8де",
+	"\xb8з",
+	"\xb8з\xd0",
+	"\xb8зв",
+	"\xb8зи",
+	"\xb8л",
+	"\xb8л\xd1",
+	"\xb8ль",
+	"\xb8\xd1",
+	"\xb8р",
+	"\xb8р\xd0",
+	"\xb8ра",
+	"\xb8с",
+	"\xb8с\xd0",
+	"\xb8ск",
+	"\xb8с\xd1",
+	"\xb8ст",
+	"\xba",
+	"\xba\xd0",
+	"\xbaв",
+	"\xbaв\xd0",
+	"\xbaве",
+	"\xbaн",
+	"\xbaн\xd0",
+	"\xbaни",
+	"\xbaо",
+	"\xbaо\xd0",
+	"\xbaом",
+	"\xbaо\xd1",
+	"\xbaор",
+	"\xbaот",
+	"\xba\xd1",
+	"\xbaс",
+	"\xbaс\xd1",
+	"\xbaст",
+	"\xbb",
+	"\xbb\xd0",
+	"\xbbе",
+	"\xbbе\xd0",
+	"\xbbед",
+	"\xbbи",
+	"\xbbи\xd0",
+	"\xbbиз",
+	"\xbb\xd1",
+	"\xbbь",
+	"\xbbь\xd1",
+	"\xbbьш",
+	"\xbc",
+	"\xbc\xd0",
+	"\xbcм",
+	"\xbcм\xd0",
+	"\xbcма",
+	"\xbcн",
+	"\xbcн\xd0",
+	"\xbcно",
+	"\xbcо",
+	"\xbcо\xd0",
+	"\xbcож",
+	"\xbcп",
+	"\xbcп\xd0",
+	"\xbcпа",
+	"\xbd",
+	"\xbd\xd0",
+	"\xbdа",
+	"\xbdа_",
+	"\xbdа__",
+	"\xbdа\xd0",
+	"\xbdаз",
+	"\xbdал",
+	"\xbdж",
+	"\xbdж\xd0",
+	"\xbdжи",
+	"\xbdи",
+	"\xbdи\xd0",
+	"\xbdив",
+	"\xbdиг",
+	"\xbdн",
+	"\xbdн\xd0",
+	"\xbdно",
+	"\xbdо",
+	"\xbdо\xd0",
+	"\xbdов",
+	"\xbdог",
+	"\xbe",
+	"\xbe\xd0",
+	"\xbeб",
+	"\xbeб\xd1",
+	"\xbeбр",
+	"\xbeв",
+	"\xbeв\xd0",
+	"\xbeва",
+	"\xbeв\xd1",
+	"\xbeвс",
+	"\xbeвт",
+	"\xbeвы",
+	"\xbeг",
+	"\xbeг\xd0",
+	"\xbeги",
+	"\xbeд",
+	"\xbeд\xd0",
+	"\xbeдн",
+	"\xbeж",
+	"\xbeж\xd0",
+	"\xbeже",
+	"\xbeи",
+	"\xbeи\xd1",
+	"\xbeис",
+	"\xbeл",
+	"\xbeл\xd1",
+	"\xbeль",
+	"\xbeм",
+	"\xbeм\xd0",
+	"\xbeмп",
+	"\xbeп",
+	"\xbeп\xd0",
+	"\xbeпи",
+	"\xbeп\xd1",
+	"\xbeпр",
+	"\xbe\xd1",
+	"\xbeр",
+	"\xbeр\xd0",
+	"\xbeро",
+	"\xbeр\xd1",
+	"\xbeря",
+	"\xbeс",
+	"\xbeс\xd0",
+	"\xbeсл",
+	"\xbeс\xd1",
+	"\xbeст",
+	"\xbeт",
+	"\xbeт_",
+	"\xbeт__",
+	"\xbeт\xd0",
+	"\xbeта",
+	"\xbeтк",
+	"\xbeто",
+	"\xbeтп",
+	"\xbeт\xd1",
+	"\xbeтр",
+	"\xbeф",
+	"\xbeф\xd0",
+	"\xbeфи",
+	"\xbeч",
+	"\xbeч\xd0",
+	"\xbeче",
+	"\xbf",
+	"\xbf\xd0",
+	"\xbfа",
+	"\xbfа\xd0",
+	"\xbfак",
+	"\xbfе",
+	"\xbfе\xd1",
+	"\xbfеч",
+	"\xbfи",
+	"\xbfи\xd1",
+	"\xbfир",
+	"\xbfо",
+	"\xbfо\xd0",
+	"\xbfов",
+	"\xbfои",
+	"\xbfо\xd1",
+	"\xbfос",
+	"\xbf\xd1",
+	"\xbfр",
+	"\xbfр\xd0",
+	"\xbfре",
+	"\xbfро",
+	"\xd0",
+	"О",
+	"О\xd0",
+	"Оп",
+	"Оп\xd1",
+	"Р",
+	"Р\xd0",
+	"Ра",
+	"Ра\xd0",
+	"Т",
+	"Т\xd0",
+	"Та",
+	"Та_",
+	"Х",
+	"Х\xd0",
+	"Хо",
+	"Хо\xd1",
+	"а",
+	"а\xd0",
+	"аб",
+	"аб\xd0",
+	"ав",
+	"ав\xd0",
+	"аж",
+	"аж\xd0",
+	"аз",
+	"аз\xd1",
+	"ак",
+	"ак\xd1",
+	"ал",
+	"ал\xd0",
+	"ам",
+	"ам\xd0",
+	"ам\xd1",
+	"ан",
+	"ан\xd0",
+	"а\xd1",
+	"ас",
+	"ас\xd1",
+	"ат",
+	"ат\xd0",
+	"аю",
+	"аю\xd1",
+	"б",
+	"б\xd0",
+	"би",
+	"би\xd0",
+	"бо",
+	"бо\xd0",
+	"бо\xd1",
+	"б\xd1",
+	"бр",
+	"бр\xd0",
+	"бу",
+	"бу\xd0",
+	"в",
+	"в_",
+	"в__",
+	"в___",
+	"в\xd0",
+	"ва",
+	"ва\xd0",
+	"ва\xd1",
+	"вв",
+	"вв\xd0",
+	"ве",
+	"ве\xd0",
+	"ве\xd1",
+	"вн",
+	"вн\xd0",
+	"в\xd1",
+	"вс",
+	"вс\xd0",
+	"вт",
+	"вт\xd0",
+	"вы",
+	"вы\xd0",
+	"г",
+	"г\xd0",
+	"го",
+	"го_",
+	"г\xd1",
+	"гр",
+	"гр\xd0",
+	"д",
+	"д\xd0",
+	"да",
+	"да\xd0",
+	"де",
+	"де\xd0",
+	"де\xd1",
+	"дк",
+	"дк\xd0",
+	"дл",
+	"дл\xd1",
+	"дн",
+	"дн\xd0",
+	"до",
+	"до\xd0",
+	"до\xd1",
+	"д\xd1",
+	"дё",
+	"дё\xd0",
+	"е",
+	"е\xd0",
+	"еб",
+	"еб_",
+	"ег",
+	"ег\xd0",
+	"ед",
+	"ед\xd0",
+	"ед\xd1",
+	"ек",
+	"ек\xd1",
+	"ел",
+	"ел\xd0",
+	"ен",
+	"ен\xd0",
+	"ен\xd1",
+	"е\xd1",
+	"ер",
+	"ер\xd0",
+	"ес",
+	"ес\xd1",
+	"еч",
+	"еч\xd0",
+	"ж",
+	"ж\xd0",
+	"же",
+	"же_",
+	"же\xd1",
+	"жи",
+	"жи\xd1",
+	"з",
+	"з\xd0",
+	"за",
+	"за\xd1",
+	"зв",
+	"зв\xd0",
+	"зи",
+	"зи\xd1",
+	"з\xd1",
+	"зу",
+	"зу\xd0",
+	"зы",
+	"зы\xd0",
+	"и",
+	"и_",
+	"и__",
+	"и___",
+	"и\xd0",
+	"иг",
+	"иг\xd0",
+	"ид",
+	"ид\xd0",
+	"из",
+	"из\xd0",
+	"ил",
+	"ил\xd1",
+	"и\xd1",
+	"ир",
+	"ир\xd0",
+	"ис",
+	"ис\xd0",
+	"ис\xd1",
+	"к",
+	"к_",
+	"к__",
+	"к___",
+	"к\xd0",
+	"кв",
+	"кв\xd0",
+	"кн",
+	"кн\xd0",
+	"ко",
+	"ко\xd0",
+	"ко\xd1",
+	"к\xd1",
+	"кс",
+	"кс\xd1",
+	"л",
+	"л\xd0",
+	"ле",
+	"ле\xd0",
+	"ли",
+	"ли\xd0",
+	"л\xd1",
+	"ль",
+	"ль\xd1",
+	"ля",
+	"ля_",
+	"м",
+	"м\xd0",
+	"мм",
+	"мм\xd0",
+	"мн",
+	"мн\xd0",
+	"мо",
+	"мо\xd0",
+	"мп",
+	"мп\xd0",
+	"м\xd1",
+	"мы",
+	"мы\xd0",
+	"мы\xd1",
+	"н",
+	"н\xd0",
+	"на",
+	"на_",
+	"на\xd0",
+	"нж",
+	"нж\xd0",
+	"ни",
+	"ни\xd0",
+	"нн",
+	"нн\xd0",
+	"но",
+	"но\xd0",
+	"о",
+	"о\xd0",
+	"об",
+	"об\xd1",
+	"ов",
+	"ов\xd0",
+	"ов\xd1",
+	"ог",
+	"ог\xd0",
+	"од",
+	"од\xd0",
+	"ож",
+	"ож\xd0",
+	"ои",
+	"ои\xd1",
+	"ол",
+	"ол\xd1",
+	"ом",
+	"ом\xd0",
+	"оп",
+	"оп\xd0",
+	"оп\xd1",
+	"о\xd1",
+	"ор",
+	"ор\xd0",
+	"ор\xd1",
+	"ос",
+	"ос\xd0",
+	"ос\xd1",
+	"от",
+	"от_",
+	"от\xd0",
+	"от\xd1",
+	"оф",
+	"оф\xd0",
+	"оч",
+	"оч\xd0",
+	"ош",
+	"ош\xd0",
+	"п",
+	"п\xd0",
+	"па",
+	"па\xd0",
+	"пе",
+	"пе\xd1",
+	"пи",
+	"пи\xd1",
+	"по",
+	"по\xd0",
+	"по\xd1",
+	"п\xd1",
+	"пр",
+	"пр\xd0",
+	"\xd1",
+	"р",
+	"р\xd0",
+	"ра",
+	"ра\xd0",
+	"ра\xd1",
+	"ре",
+	"ре\xd0",
+	"ро",
+	"ро\xd0",
+	"ро\xd1",
+	"р\xd1",
+	"ры",
+	"ры\xd0",
+	"ря",
+	"ря\xd1",
+	"с",
+	"с_",
+	"с__",
+	"с___",
+	"с\xd0",
+	"са",
+	"са\xd0",
+	"се",
+	"се\xd0",
+	"си",
+	"си\xd1",
+	"сл",
+	"сл\xd0",
+	"со",
+	"со_",
+	"с\xd1",
+	"ср",
+	"ср\xd0",
+	"ст",
+	"ст\xd0",
+	"ст\xd1",
+	"т",
+	"т\xd0",
+	"та",
+	"та\xd0",
+	"та\xd1",
+	"те",
+	"те\xd0",
+	"ти",
+	"ти_",
+	"ти\xd0",
+	"то",
+	"то\xd1",
+	"тп",
+	"тп\xd0",
+	"т\xd1",
+	"тр",
+	"тр\xd0",
+	"тр\xd1",
+	"у",
+	"у\xd0",
+	"ув",
+	"ув\xd0",
+	"ук",
+	"ук\xd0",
+	"ум",
+	"ум\xd0",
+	"ф",
+	"ф\xd0",
+	"фи",
+	"фи\xd0",
+	"ф\xd1",
+	"фр",
+	"фр\xd0",
+	"ч",
+	"ч\xd0",
+	"ча",
+	"ча\xd1",
+	"че",
+	"че\xd0",
+	"ы",
+	"ы\xd0",
+	"ыв",
+	"ыв\xd0",
+	"ык",
+	"ык\xd0",
+	"ь",
+	"ь\xd1",
+	"ьш",
+	"ьш\xd0",
+	"э",
+	"э\xd1",
+	"эт",
+	"эт\xd0",
+	"я",
+	"я\xd0",
+	"яз",
+	"яз\xd1",
+	"ё",
+	"ё\xd0",
+	"ён",
+	"ён\xd0",
+}
+
+var ranks = []uint16{
+	566,
+	6,
+	12,
+	565,
+	564,
+	5,
+	1082,
+	1081,
+	1080,
+	1079,
+	1078,
+	318,
+	226,
+	563,
+	95,
+	1077,
+	1076,
+	1075,
+	94,
+	93,
+	562,
+	317,
+	58,
+	115,
+	11,
+	316,
+	43,
+	315,
+	1074,
+	1073,
+	561,
+	560,
+	225,
+	559,
+	558,
+	557,
+	4,
+	1072,
+	1071,
+	1070,
+	1069,
+	1068,
+	1067,
+	1066,
+	1065,
+	1064,
+	1063,
+	314,
+	1062,
+	556,
+	224,
+	555,
+	554,
+	553,
+	552,
+	92,
+	91,
+	1061,
+	1060,
+	1059,
+	1058,
+	1057,
+	1056,
+	90,
+	157,
+	551,
+	89,
+	1055,
+	114,
+	550,
+	549,
+	313,
+	312,
+	57,
+	156,
+	113,
+	112,
+	155,
+	1054,
+	10,
+	311,
+	310,
+	42,
+	309,
+	154,
+	153,
+	308,
+	307,
+	1053,
+	1052,
+	1051,
+	1050,
+	548,
+	547,
+	546,
+	545,
+	223,
+	222,
+	544,
+	543,
+	542,
+	541,
+	3,
+	1049,
+	1048,
+	1047,
+	1046,
+	1045,
+	1044,
+	1043,
+	1042,
+	1041,
+	1040,
+	1039,
+	1038,
+	1037,
+	1036,
+	1035,
+	306,
+	1034,
+	1033,
+	540,
+	539,
+	221,
+	538,
+	537,
+	536,
+	1032,
+	1031,
+	535,
+	534,
+	533,
+	88,
+	87,
+	532,
+	220,
+	1030,
+	1029,
+	1028,
+	1027,
+	1026,
+	1025,
+	1024,
+	1023,
+	1022,
+	1021,
+	86,
+	152,
+	151,
+	531,
+	1020,
+	1019,
+	85,
+	1018,
+	1017,
+	111,
+	1016,
+	150,
+	530,
+	529,
+	1015,
+	1014,
+	305,
+	304,
+	528,
+	1013,
+	56,
+	149,
+	527,
+	1012,
+	526,
+	110,
+	148,
+	1011,
+	109,
+	147,
+	146,
+	1010,
+	1009,
+	9,
+	303,
+	302,
+	525,
+	1008,
+	41,
+	301,
+	300,
+	145,
+	524,
+	523,
+	1007,
+	144,
+	522,
+	299,
+	298,
+	297,
+	296,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	1001,
+	521,
+	520,
+	519,
+	518,
+	517,
+	516,
+	219,
+	218,
+	217,
+	515,
+	514,
+	513,
+	512,
+	511,
+	15,
+	20,
+	55,
+	66,
+	1000,
+	510,
+	216,
+	509,
+	999,
+	998,
+	997,
+	215,
+	214,
+	295,
+	996,
+	108,
+	995,
+	994,
+	143,
+	294,
+	993,
+	992,
+	508,
+	507,
+	506,
+	505,
+	25,
+	84,
+	504,
+	503,
+	502,
+	991,
+	990,
+	989,
+	501,
+	500,
+	499,
+	988,
+	987,
+	986,
+	985,
+	984,
+	983,
+	48,
+	498,
+	497,
+	496,
+	65,
+	107,
+	495,
+	494,
+	982,
+	981,
+	213,
+	293,
+	980,
+	28,
+	54,
+	493,
+	979,
+	978,
+	977,
+	976,
+	292,
+	291,
+	290,
+	975,
+	974,
+	973,
+	289,
+	288,
+	492,
+	972,
+	491,
+	490,
+	489,
+	142,
+	141,
+	287,
+	971,
+	488,
+	487,
+	486,
+	212,
+	211,
+	970,
+	969,
+	968,
+	485,
+	484,
+	483,
+	967,
+	966,
+	965,
+	482,
+	964,
+	963,
+	962,
+	961,
+	960,
+	959,
+	958,
+	957,
+	210,
+	209,
+	286,
+	285,
+	481,
+	956,
+	955,
+	954,
+	953,
+	284,
+	283,
+	480,
+	479,
+	952,
+	951,
+	950,
+	949,
+	948,
+	478,
+	477,
+	476,
+	475,
+	474,
+	208,
+	207,
+	206,
+	205,
+	204,
+	947,
+	946,
+	945,
+	944,
+	943,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	935,
+	934,
+	933,
+	932,
+	931,
+	930,
+	929,
+	928,
+	19,
+	26,
+	473,
+	472,
+	927,
+	926,
+	471,
+	470,
+	469,
+	925,
+	924,
+	923,
+	468,
+	467,
+	922,
+	921,
+	920,
+	919,
+	918,
+	917,
+	916,
+	915,
+	203,
+	466,
+	465,
+	464,
+	463,
+	202,
+	201,
+	914,
+	913,
+	912,
+	911,
+	140,
+	462,
+	461,
+	460,
+	282,
+	281,
+	459,
+	910,
+	83,
+	280,
+	909,
+	908,
+	907,
+	458,
+	906,
+	905,
+	904,
+	903,
+	200,
+	457,
+	456,
+	455,
+	454,
+	453,
+	452,
+	47,
+	64,
+	451,
+	902,
+	901,
+	900,
+	899,
+	898,
+	897,
+	896,
+	139,
+	279,
+	895,
+	894,
+	893,
+	450,
+	892,
+	891,
+	449,
+	448,
+	447,
+	446,
+	890,
+	889,
+	888,
+	887,
+	886,
+	885,
+	445,
+	444,
+	443,
+	442,
+	441,
+	34,
+	38,
+	440,
+	439,
+	884,
+	883,
+	278,
+	438,
+	437,
+	882,
+	881,
+	880,
+	879,
+	878,
+	199,
+	198,
+	197,
+	436,
+	435,
+	877,
+	876,
+	434,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	33,
+	53,
+	867,
+	866,
+	865,
+	106,
+	138,
+	433,
+	864,
+	863,
+	862,
+	861,
+	860,
+	277,
+	276,
+	275,
+	859,
+	858,
+	857,
+	196,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	432,
+	431,
+	430,
+	429,
+	428,
+	850,
+	849,
+	848,
+	847,
+	846,
+	845,
+	72,
+	427,
+	844,
+	843,
+	842,
+	841,
+	840,
+	839,
+	105,
+	838,
+	837,
+	836,
+	137,
+	136,
+	835,
+	195,
+	71,
+	194,
+	834,
+	833,
+	832,
+	426,
+	425,
+	831,
+	830,
+	829,
+	828,
+	827,
+	193,
+	826,
+	825,
+	824,
+	274,
+	823,
+	822,
+	424,
+	423,
+	70,
+	82,
+	821,
+	820,
+	819,
+	818,
+	817,
+	816,
+	135,
+	815,
+	814,
+	192,
+	273,
+	813,
+	422,
+	421,
+	420,
+	419,
+	272,
+	418,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	803,
+	191,
+	190,
+	802,
+	801,
+	800,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	52,
+	51,
+	271,
+	790,
+	789,
+	417,
+	788,
+	787,
+	786,
+	785,
+	784,
+	270,
+	269,
+	416,
+	783,
+	415,
+	414,
+	413,
+	412,
+	411,
+	782,
+	781,
+	8,
+	37,
+	410,
+	409,
+	408,
+	189,
+	780,
+	779,
+	268,
+	778,
+	777,
+	776,
+	775,
+	774,
+	773,
+	772,
+	771,
+	770,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	763,
+	762,
+	761,
+	760,
+	759,
+	758,
+	407,
+	757,
+	756,
+	755,
+	754,
+	24,
+	134,
+	188,
+	187,
+	753,
+	752,
+	267,
+	751,
+	750,
+	406,
+	405,
+	63,
+	749,
+	748,
+	81,
+	747,
+	266,
+	746,
+	404,
+	403,
+	402,
+	745,
+	744,
+	743,
+	742,
+	741,
+	740,
+	46,
+	69,
+	739,
+	738,
+	737,
+	401,
+	400,
+	399,
+	736,
+	735,
+	734,
+	133,
+	265,
+	398,
+	733,
+	397,
+	396,
+	264,
+	263,
+	262,
+	395,
+	732,
+	1,
+	731,
+	730,
+	729,
+	728,
+	727,
+	726,
+	725,
+	724,
+	723,
+	722,
+	721,
+	720,
+	719,
+	718,
+	717,
+	716,
+	13,
+	23,
+	394,
+	393,
+	392,
+	391,
+	715,
+	714,
+	132,
+	131,
+	713,
+	712,
+	711,
+	710,
+	186,
+	390,
+	389,
+	185,
+	184,
+	104,
+	388,
+	387,
+	261,
+	260,
+	709,
+	708,
+	80,
+	259,
+	707,
+	706,
+	386,
+	705,
+	704,
+	183,
+	385,
+	384,
+	383,
+	382,
+	32,
+	381,
+	380,
+	379,
+	62,
+	378,
+	703,
+	702,
+	701,
+	700,
+	130,
+	258,
+	377,
+	376,
+	375,
+	257,
+	699,
+	698,
+	697,
+	696,
+	695,
+	694,
+	256,
+	693,
+	692,
+	691,
+	374,
+	373,
+	372,
+	31,
+	36,
+	371,
+	370,
+	255,
+	369,
+	690,
+	689,
+	688,
+	182,
+	181,
+	368,
+	367,
+	366,
+	687,
+	686,
+	685,
+	684,
+	683,
+	22,
+	27,
+	682,
+	681,
+	680,
+	679,
+	103,
+	129,
+	678,
+	254,
+	253,
+	365,
+	364,
+	252,
+	363,
+	677,
+	180,
+	676,
+	675,
+	674,
+	673,
+	362,
+	361,
+	251,
+	250,
+	360,
+	672,
+	671,
+	670,
+	669,
+	68,
+	249,
+	668,
+	667,
+	666,
+	665,
+	664,
+	663,
+	102,
+	662,
+	661,
+	128,
+	127,
+	30,
+	126,
+	125,
+	124,
+	101,
+	660,
+	659,
+	658,
+	657,
+	359,
+	358,
+	357,
+	356,
+	179,
+	656,
+	655,
+	248,
+	654,
+	355,
+	50,
+	653,
+	652,
+	651,
+	79,
+	650,
+	649,
+	648,
+	647,
+	123,
+	646,
+	178,
+	247,
+	246,
+	245,
+	78,
+	354,
+	645,
+	644,
+	643,
+	642,
+	122,
+	641,
+	640,
+	177,
+	176,
+	100,
+	175,
+	639,
+	638,
+	637,
+	636,
+	635,
+	634,
+	633,
+	632,
+	353,
+	352,
+	631,
+	630,
+	40,
+	39,
+	244,
+	629,
+	351,
+	628,
+	627,
+	243,
+	242,
+	350,
+	349,
+	174,
+	173,
+	7,
+	35,
+	348,
+	347,
+	172,
+	626,
+	241,
+	625,
+	624,
+	623,
+	622,
+	621,
+	620,
+	619,
+	618,
+	617,
+	616,
+	615,
+	614,
+	346,
+	613,
+	612,
+	18,
+	99,
+	171,
+	345,
+	240,
+	611,
+	344,
+	61,
+	610,
+	77,
+	343,
+	609,
+	608,
+	607,
+	606,
+	605,
+	604,
+	45,
+	67,
+	603,
+	602,
+	342,
+	341,
+	601,
+	600,
+	121,
+	239,
+	340,
+	238,
+	237,
+	236,
+	2,
+	14,
+	17,
+	49,
+	60,
+	599,
+	170,
+	169,
+	76,
+	339,
+	120,
+	235,
+	338,
+	337,
+	598,
+	597,
+	16,
+	234,
+	233,
+	232,
+	75,
+	336,
+	335,
+	596,
+	595,
+	334,
+	333,
+	594,
+	593,
+	592,
+	591,
+	44,
+	332,
+	331,
+	59,
+	98,
+	168,
+	21,
+	29,
+	330,
+	590,
+	589,
+	97,
+	96,
+	329,
+	588,
+	587,
+	231,
+	230,
+	328,
+	327,
+	119,
+	118,
+	229,
+	326,
+	167,
+	166,
+	586,
+	585,
+	325,
+	324,
+	584,
+	583,
+	323,
+	582,
+	581,
+	580,
+	579,
+	578,
+	577,
+	117,
+	116,
+	165,
+	164,
+	576,
+	575,
+	74,
+	73,
+	228,
+	227,
+	163,
+	162,
+	574,
+	573,
+	572,
+	571,
+	322,
+	321,
+	320,
+	319,
+	161,
+	160,
+	159,
+	158,
+	570,
+	569,
+	568,
+	567,
+}
+
+// Profile is the pre-built russian language profile.
+var Profile = langdet.Language{
+	Name:    "russian",
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse("ru"),
+	Scripts: []langdet.Script{langdet.ScriptCyrillic},
+}
@@ -0,0 +1,3234 @@
+// Code generated by cmd/genprofiles from cmd/genprofiles/corpus/turkish.txt; DO NOT EDIT.
+
+// Package tr provides the pre-built turkish langdet.Language profile on its own, so
+// importing it doesn't pull in every other bundled language.
+package tr
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	"golang.org/x/text/language"
+)
+
+var tokens = []string{
+	"A",
+	"Ay",
+	"Ayn",
+	"Ayn\xc4",
+	"Aynı",
+	"B",
+	"Bu",
+	"Bu_",
+	"Bu__",
+	"Bu___",
+	"D",
+	"Di",
+	"Dil",
+	"Dil_",
+	"Dil__",
+	"_A",
+	"_Ay",
+	"_Ayn",
+	"_Ayn\xc4",
+	"_B",
+	"_Bu",
+	"_Bu_",
+	"_Bu__",
+	"_D",
+	"_Di",
+	"_Dil",
+	"_Dil_",
+	"__A",
+	"__Ay",
+	"__Ayn",
+	"__B",
+	"__Bu",
+	"__Bu_",
+	"__D",
+	"__Di",
+	"__Dil",
+	"___A",
+	"___Ay",
+	"___B",
+	"___Bu",
+	"___D",
+	"___Di",
+	"____A",
+	"____B",
+	"____D",
+	"____a",
+	"____b",
+	"____c",
+	"____d",
+	"____e",
+	"____f",
+	"____g",
+	"____h",
+	"____i",
+	"____k",
+	"____m",
+	"____n",
+	"____o",
+	"____p",
+	"____s",
+	"____t",
+	"____v",
+	"____w",
+	"____y",
+	"____\xc3",
+	"____\xc4",
+	"___a",
+	"___ad",
+	"___ar",
+	"___az",
+	"___b",
+	"___bi",
+	"___bu",
+	"___b\xc3",
+	"___c",
+	"___c\xc3",
+	"___d",
+	"___da",
+	"___de",
+	"___di",
+	"___do",
+	"___e",
+	"___ed",
+	"___f",
+	"___fi",
+	"___g",
+	"___ge",
+	"___gr",
+	"___g\xc3",
+	"___h",
+	"___ha",
+	"___i",
+	"___in",
+	"___iz",
+	"___i\xc3",
+	"___k",
+	"___ka",
+	"___ki",
+	"___ku",
+	"___k\xc3",
+	"___k\xc4",
+	"___m",
+	"___ma",
+	"___me",
+	"___n",
+	"___n_",
+	"___o",
+	"___ol",
+	"___p",
+	"___pa",
+	"___pr",
+	"___s",
+	"___sa",
+	"___si",
+	"___so",
+	"___s\xc4",
+	"___t",
+	"___ta",
+	"___te",
+	"___v",
+	"___ve",
+	"___w",
+	"___we",
+	"___y",
+	"___ya",
+	"___ye",
+	"___yo",
+	"___\xc3",
+	"___ç",
+	"___ö",
+	"___\xc4",
+	"___İ",
+	"__a",
+	"__ad",
+	"__ad\xc4",
+	"__ar",
+	"__ara",
+	"__az",
+	"__az_",
+	"__b",
+	"__bi",
+	"__bil",
+	"__bir",
+	"__bu",
+	"__bu_",
+	"__b\xc3",
+	"__bü",
+	"__c",
+	"__c\xc3",
+	"__cü",
+	"__d",
+	"__da",
+	"__da_",
+	"__dah",
+	"__day",
+	"__de",
+	"__de_",
+	"__di",
+	"__dil",
+	"__diz",
+	"__do",
+	"__do\xc4",
+	"__e",
+	"__ed",
+	"__ede",
+	"__f",
+	"__fi",
+	"__fik",
+	"__g",
+	"__ge",
+	"__ge\xc3",
+	"__gr",
+	"__gra",
+	"__g\xc3",
+	"__gü",
+	"__h",
+	"__ha",
+	"__har",
+	"__i",
+	"__in",
+	"__inc",
+	"__iz",
+	"__izi",
+	"__i\xc3",
+	"__iç",
+	"__k",
+	"__ka",
+	"__kar",
+	"__ki",
+	"__kit",
+	"__ku",
+	"__kul",
+	"__kut",
+	"__k\xc3",
+	"__kü",
+	"__k\xc4",
+	"__kı",
+	"__m",
+	"__ma",
+	"__mak",
+	"__me",
+	"__met",
+	"__n",
+	"__n_",
+	"__n__",
+	"__o",
+	"__ol",
+	"__olu",
+	"__p",
+	"__pa",
+	"__par",
+	"__pr",
+	"__pro",
+	"__s",
+	"__sa",
+	"__say",
+	"__si",
+	"__sis",
+	"__so",
+	"__son",
+	"__s\xc4",
+	"__sı",
+	"__t",
+	"__ta",
+	"__tan",
+	"__te",
+	"__tek",
+	"__v",
+	"__ve",
+	"__ve_",
+	"__ver",
+	"__w",
+	"__we",
+	"__web",
+	"__y",
+	"__ya",
+	"__ya_",
+	"__yaz",
+	"__ye",
+	"__yen",
+	"__yet",
+	"__yo",
+	"__yo\xc4",
+	"__\xc3",
+	"__ç",
+	"__ço",
+	"__ö",
+	"__ör",
+	"__\xc4",
+	"__İ",
+	"__İy",
+	"_a",
+	"_ad",
+	"_ad\xc4",
+	"_adı",
+	"_ar",
+	"_ara",
+	"_aram",
+	"_az",
+	"_az_",
+	"_az__",
+	"_b",
+	"_bi",
+	"_bil",
+	"_bile",
+	"_bili",
+	"_bir",
+	"_bir_",
+	"_bir\xc3",
+	"_bu",
+	"_bu_",
+	"_bu__",
+	"_b\xc3",
+	"_bü",
+	"_büy",
+	"_c",
+	"_c\xc3",
+	"_cü",
+	"_cüm",
+	"_d",
+	"_da",
+	"_da_",
+	"_da__",
+	"_dah",
+	"_daha",
+	"_day",
+	"_daya",
+	"_de",
+	"_de_",
+	"_de__",
+	"_di",
+	"_dil",
+	"_dil_",
+	"_dili",
+	"_diz",
+	"_dizi",
+	"_do",
+	"_do\xc4",
+	"_doğ",
+	"_e",
+	"_ed",
+	"_ede",
+	"_eder",
+	"_f",
+	"_fi",
+	"_fik",
+	"_fiki",
+	"_g",
+	"_ge",
+	"_ge\xc3",
+	"_geç",
+	"_gr",
+	"_gra",
+	"_gram",
+	"_g\xc3",
+	"_gü",
+	"_gün",
+	"_güv",
+	"_h",
+	"_ha",
+	"_har",
+	"_harf",
+	"_i",
+	"_in",
+	"_inc",
+	"_ince",
+	"_iz",
+	"_izi",
+	"_izin",
+	"_iziy",
+	"_i\xc3",
+	"_iç",
+	"_içi",
+	"_k",
+	"_ka",
+	"_kar",
+	"_kara",
+	"_kar\xc5",
+	"_ki",
+	"_kit",
+	"_kita",
+	"_ku",
+	"_kul",
+	"_kull",
+	"_kut",
+	"_kutu",
+	"_k\xc3",
+	"_kü",
+	"_kü\xc3",
+	"_k\xc4",
+	"_kı",
+	"_kıs",
+	"_m",
+	"_ma",
+	"_mak",
+	"_maku",
+	"_me",
+	"_met",
+	"_meti",
+	"_metn",
+	"_n",
+	"_n_",
+	"_n__",
+	"_n___",
+	"_o",
+	"_ol",
+	"_olu",
+	"_olu\xc5",
+	"_p",
+	"_pa",
+	"_par",
+	"_parm",
+	"_par\xc3",
+	"_pr",
+	"_pro",
+	"_prof",
+	"_s",
+	"_sa",
+	"_say",
+	"_sayf",
+	"_si",
+	"_sis",
+	"_sist",
+	"_so",
+	"_son",
+	"_sonr",
+	"_s\xc4",
+	"_sı",
+	"_sık",
+	"_sır",
+	"_t",
+	"_ta",
+	"_tan",
+	"_tan\xc4",
+	"_te",
+	"_tek",
+	"_tek_",
+	"_tekr",
+	"_v",
+	"_ve",
+	"_ve_",
+	"_ve__",
+	"_ver",
+	"_veri",
+	"_w",
+	"_we",
+	"_web",
+	"_web_",
+	"_y",
+	"_ya",
+	"_ya_",
+	"_ya__",
+	"_yaz",
+	"_yaz\xc4",
+	"_ye",
+	"_yen",
+	"_yeni",
+	"_yet",
+	"_yete",
+	"_yo",
+	"_yo\xc4",
+	"_yoğ",
+	"_\xc3",
+	"_ç",
+	"_ço",
+	"_çok",
+	"_ö",
+	"_ör",
+	"_örn",
+	"_ör\xc3",
+	"_\xc4",
+	"_İ",
+	"_İy",
+	"_İyi",
+	"a",
+	"a_",
+	"a__",
+	"a___",
+	"a____",
+	"ab",
+	"abi",
+	"abil",
+	"abili",
+	"ad",
+	"ad\xc4",
+	"adı",
+	"adı_",
+	"ah",
+	"aha",
+	"aha_",
+	"aha__",
+	"ak",
+	"ak_",
+	"ak__",
+	"ak___",
+	"aku",
+	"akul",
+	"akul_",
+	"al",
+	"ala",
+	"alay",
+	"alaya",
+	"am",
+	"am_",
+	"am__",
+	"am___",
+	"ama",
+	"ama_",
+	"ama__",
+	"aml",
+	"amla",
+	"amlar",
+	"an",
+	"an_",
+	"an__",
+	"an___",
+	"ana",
+	"ana_",
+	"ana__",
+	"and",
+	"anda",
+	"andan",
+	"an\xc4",
+	"anı",
+	"anıl",
+	"anım",
+	"anır",
+	"ap",
+	"ap_",
+	"ap__",
+	"ap___",
+	"ar",
+	"ar_",
+	"ar__",
+	"ar___",
+	"ara",
+	"arak",
+	"arak_",
+	"aram",
+	"arama",
+	"arar",
+	"ararl",
+	"arf",
+	"arf_",
+	"arf__",
+	"arl",
+	"arl\xc4",
+	"arlı",
+	"arm",
+	"arma",
+	"armak",
+	"ar\xc3",
+	"arç",
+	"arça",
+	"ar\xc4",
+	"arı",
+	"arı_",
+	"ar\xc5",
+	"arş",
+	"arş\xc4",
+	"as",
+	"as\xc4",
+	"ası",
+	"ası_",
+	"asın",
+	"ay",
+	"aya",
+	"ayan",
+	"ayan\xc4",
+	"ayar",
+	"ayara",
+	"ayf",
+	"ayfa",
+	"ayfas",
+	"az",
+	"az_",
+	"az__",
+	"az___",
+	"az\xc4",
+	"azı",
+	"azıl",
+	"azın",
+	"a\xc5",
+	"aş",
+	"aşt",
+	"aşt\xc4",
+	"b",
+	"b_",
+	"b__",
+	"b___",
+	"b____",
+	"bi",
+	"bil",
+	"bile",
+	"bile_",
+	"bili",
+	"bilin",
+	"bilir",
+	"bir",
+	"bir_",
+	"bir__",
+	"bir\xc3",
+	"birç",
+	"bu",
+	"bu_",
+	"bu__",
+	"bu___",
+	"b\xc3",
+	"bü",
+	"büy",
+	"büy\xc3",
+	"c",
+	"ce",
+	"ce_",
+	"ce__",
+	"ce___",
+	"cel",
+	"cele",
+	"celer",
+	"c\xc3",
+	"cü",
+	"cüm",
+	"cüml",
+	"d",
+	"da",
+	"da_",
+	"da__",
+	"da___",
+	"dah",
+	"daha",
+	"daha_",
+	"dan",
+	"dan_",
+	"dan__",
+	"day",
+	"daya",
+	"dayan",
+	"de",
+	"de_",
+	"de__",
+	"de___",
+	"der",
+	"der_",
+	"der__",
+	"di",
+	"dil",
+	"dil_",
+	"dil__",
+	"dili",
+	"dilin",
+	"dir",
+	"dir_",
+	"dir__",
+	"diz",
+	"dizi",
+	"dizil",
+	"do",
+	"do\xc4",
+	"doğ",
+	"doğr",
+	"d\xc4",
+	"dı",
+	"dı_",
+	"dı__",
+	"e",
+	"e_",
+	"e__",
+	"e___",
+	"e____",
+	"eb",
+	"eb_",
+	"eb__",
+	"eb___",
+	"ed",
+	"ede",
+	"eder",
+	"eder_",
+	"ek",
+	"ek_",
+	"ek__",
+	"ek___",
+	"ekl",
+	"ekle",
+	"ekler",
+	"ekr",
+	"ekra",
+	"ekrar",
+	"el",
+	"ele",
+	"eler",
+	"eler_",
+	"em",
+	"em_",
+	"em__",
+	"em___",
+	"emi",
+	"emi_",
+	"emi__",
+	"en",
+	"en_",
+	"en__",
+	"en___",
+	"eni",
+	"eni_",
+	"eni__",
+	"enl",
+	"enle",
+	"enle_",
+	"er",
+	"er_",
+	"er__",
+	"er___",
+	"eri",
+	"eril",
+	"erile",
+	"erin",
+	"erinc",
+	"erind",
+	"erine",
+	"erini",
+	"erl",
+	"erle",
+	"erle_",
+	"erli",
+	"erlid",
+	"et",
+	"ete",
+	"eter",
+	"eteri",
+	"eti",
+	"etin",
+	"etin_",
+	"etn",
+	"etni",
+	"etni_",
+	"e\xc3",
+	"eç",
+	"eçe",
+	"eçer",
+	"e\xc4",
+	"eğ",
+	"eği",
+	"eğin",
+	"f",
+	"f_",
+	"f__",
+	"f___",
+	"f____",
+	"fa",
+	"fas",
+	"fas\xc4",
+	"fası",
+	"fi",
+	"fik",
+	"fiki",
+	"fikir",
+	"fil",
+	"fill",
+	"fille",
+	"g",
+	"ge",
+	"ge\xc3",
+	"geç",
+	"geçe",
+	"gr",
+	"gra",
+	"gram",
+	"gram_",
+	"graml",
+	"g\xc3",
+	"gü",
+	"gün",
+	"günl",
+	"güv",
+	"güve",
+	"h",
+	"ha",
+	"ha_",
+	"ha__",
+	"ha___",
+	"har",
+	"harf",
+	"harf_",
+	"i",
+	"i_",
+	"i__",
+	"i___",
+	"i____",
+	"id",
+	"idi",
+	"idir",
+	"idir_",
+	"ik",
+	"iki",
+	"ikir",
+	"ikir_",
+	"il",
+	"il_",
+	"il__",
+	"il___",
+	"ile",
+	"ile_",
+	"ile__",
+	"ilen",
+	"ilen_",
+	"iler",
+	"iler_",
+	"ileri",
+	"ili",
+	"ilin",
+	"ilin_",
+	"iline",
+	"ilir",
+	"ilir_",
+	"ill",
+	"ille",
+	"iller",
+	"in",
+	"in_",
+	"in__",
+	"in___",
+	"inc",
+	"ince",
+	"ince_",
+	"incel",
+	"ind",
+	"inde",
+	"inde_",
+	"ine",
+	"ine_",
+	"ine__",
+	"inen",
+	"inen_",
+	"ini",
+	"ini_",
+	"ini__",
+	"ir",
+	"ir_",
+	"ir__",
+	"ir___",
+	"ir\xc3",
+	"irç",
+	"irço",
+	"is",
+	"ist",
+	"iste",
+	"istem",
+	"it",
+	"ita",
+	"itap",
+	"itap_",
+	"iy",
+	"iyl",
+	"iyle",
+	"iyle_",
+	"iz",
+	"izi",
+	"izil",
+	"izile",
+	"izin",
+	"izini",
+	"iziy",
+	"iziyl",
+	"i\xc3",
+	"iç",
+	"içi",
+	"için",
+	"k",
+	"k_",
+	"k__",
+	"k___",
+	"k____",
+	"ka",
+	"kar",
+	"kara",
+	"karar",
+	"kar\xc5",
+	"karş",
+	"ki",
+	"kir",
+	"kir_",
+	"kir__",
+	"kit",
+	"kita",
+	"kitap",
+	"kl",
+	"kla",
+	"kla_",
+	"kla__",
+	"kle",
+	"kler",
+	"kleri",
+	"kl\xc4",
+	"klı",
+	"klık",
+	"kr",
+	"kra",
+	"krar",
+	"krar_",
+	"ku",
+	"kul",
+	"kul_",
+	"kul__",
+	"kull",
+	"kulla",
+	"kut",
+	"kutu",
+	"kutus",
+	"k\xc3",
+	"kü",
+	"kü\xc3",
+	"küç",
+	"k\xc4",
+	"kı",
+	"kıs",
+	"kısa",
+	"l",
+	"l_",
+	"l__",
+	"l___",
+	"l____",
+	"la",
+	"lan",
+	"lan_",
+	"lan__",
+	"lana",
+	"lana_",
+	"land",
+	"landa",
+	"lan\xc4",
+	"lanı",
+	"lar",
+	"lar\xc4",
+	"ları",
+	"lay",
+	"laya",
+	"layar",
+	"la\xc5",
+	"laş",
+	"laşt",
+	"le",
+	"le_",
+	"le__",
+	"le___",
+	"len",
+	"len_",
+	"len__",
+	"ler",
+	"ler_",
+	"ler__",
+	"leri",
+	"lerin",
+	"lerl",
+	"lerle",
+	"li",
+	"lid",
+	"lidi",
+	"lidir",
+	"lin",
+	"lin_",
+	"lin__",
+	"line",
+	"linen",
+	"lir",
+	"lir_",
+	"lir__",
+	"ll",
+	"lla",
+	"llan",
+	"llan\xc4",
+	"lle",
+	"ller",
+	"llerl",
+	"lu",
+	"lu\xc5",
+	"luş",
+	"luşt",
+	"l\xc3",
+	"lü",
+	"lük",
+	"lük_",
+	"l\xc4",
+	"lı",
+	"lı_",
+	"lı__",
+	"lık",
+	"lıkl",
+	"m",
+	"m_",
+	"m__",
+	"m___",
+	"m____",
+	"ma",
+	"ma_",
+	"ma__",
+	"ma___",
+	"mak",
+	"mak_",
+	"mak__",
+	"maku",
+	"makul",
+	"me",
+	"met",
+	"meti",
+	"metin",
+	"metn",
+	"metni",
+	"mi",
+	"mi_",
+	"mi__",
+	"mi___",
+	"ml",
+	"mla",
+	"mlar",
+	"mlar\xc4",
+	"mle",
+	"mle_",
+	"mle__",
+	"n",
+	"n_",
+	"n__",
+	"n___",
+	"n____",
+	"na",
+	"na_",
+	"na__",
+	"na___",
+	"nc",
+	"nce",
+	"nce_",
+	"nce__",
+	"ncel",
+	"ncele",
+	"nd",
+	"nda",
+	"ndan",
+	"ndan_",
+	"nde",
+	"nde_",
+	"nde__",
+	"ne",
+	"ne_",
+	"ne__",
+	"ne___",
+	"nek",
+	"nekl",
+	"nekle",
+	"nen",
+	"nen_",
+	"nen__",
+	"ne\xc4",
+	"neğ",
+	"neği",
+	"ni",
+	"ni_",
+	"ni__",
+	"ni___",
+	"nl",
+	"nle",
+	"nle_",
+	"nle__",
+	"nl\xc3",
+	"nlü",
+	"nlük",
+	"nr",
+	"nra",
+	"nra_",
+	"nra__",
+	"nt",
+	"nt\xc3",
+	"ntü",
+	"ntül",
+	"n\xc4",
+	"nı",
+	"nı_",
+	"nı__",
+	"nıl",
+	"nıla",
+	"nım",
+	"nıma",
+	"nın",
+	"nın_",
+	"nır",
+	"nır_",
+	"o",
+	"of",
+	"ofi",
+	"ofil",
+	"ofill",
+	"ok",
+	"ok_",
+	"ok__",
+	"ok___",
+	"ol",
+	"olu",
+	"olu\xc5",
+	"oluş",
+	"on",
+	"onr",
+	"onra",
+	"onra_",
+	"o\xc4",
+	"oğ",
+	"oğr",
+	"oğru",
+	"oğu",
+	"oğun",
+	"p",
+	"p_",
+	"p__",
+	"p___",
+	"p____",
+	"pa",
+	"par",
+	"parm",
+	"parma",
+	"par\xc3",
+	"parç",
+	"pr",
+	"pro",
+	"prof",
+	"profi",
+	"r",
+	"r_",
+	"r__",
+	"r___",
+	"r____",
+	"ra",
+	"ra_",
+	"ra__",
+	"ra___",
+	"rab",
+	"rabi",
+	"rabil",
+	"rak",
+	"rak_",
+	"rak__",
+	"ral",
+	"rala",
+	"ralay",
+	"ram",
+	"ram_",
+	"ram__",
+	"rama",
+	"rama_",
+	"raml",
+	"ramla",
+	"rar",
+	"rar_",
+	"rar__",
+	"rarl",
+	"rarl\xc4",
+	"rf",
+	"rf_",
+	"rf__",
+	"rf___",
+	"ri",
+	"ril",
+	"rile",
+	"rilen",
+	"rin",
+	"rinc",
+	"rince",
+	"rind",
+	"rinde",
+	"rine",
+	"rine_",
+	"rini",
+	"rini_",
+	"rl",
+	"rle",
+	"rle_",
+	"rle__",
+	"rli",
+	"rlid",
+	"rlidi",
+	"rl\xc4",
+	"rlı",
+	"rlı_",
+	"rm",
+	"rma",
+	"rmak",
+	"rmak_",
+	"rn",
+	"rne",
+	"rnek",
+	"rnekl",
+	"rne\xc4",
+	"rneğ",
+	"ro",
+	"rof",
+	"rofi",
+	"rofil",
+	"ru",
+	"ru_",
+	"ru__",
+	"ru___",
+	"r\xc3",
+	"rç",
+	"rça",
+	"rças",
+	"rço",
+	"rçok",
+	"rü",
+	"rün",
+	"rünt",
+	"r\xc4",
+	"rı",
+	"rı_",
+	"rı__",
+	"r\xc5",
+	"rş",
+	"rş\xc4",
+	"rşı",
+	"s",
+	"sa",
+	"sa_",
+	"sa__",
+	"sa___",
+	"say",
+	"sayf",
+	"sayfa",
+	"si",
+	"sis",
+	"sist",
+	"siste",
+	"so",
+	"son",
+	"sonr",
+	"sonra",
+	"st",
+	"ste",
+	"stem",
+	"stem_",
+	"stemi",
+	"su",
+	"sun",
+	"suna",
+	"suna_",
+	"s\xc4",
+	"sı",
+	"sı_",
+	"sı__",
+	"sık",
+	"sık_",
+	"sıkl",
+	"sın",
+	"sın\xc4",
+	"sır",
+	"sıra",
+	"t",
+	"ta",
+	"tan",
+	"tan\xc4",
+	"tanı",
+	"tap",
+	"tap_",
+	"tap__",
+	"te",
+	"tek",
+	"tek_",
+	"tek__",
+	"tekr",
+	"tekra",
+	"tem",
+	"tem_",
+	"tem__",
+	"temi",
+	"temi_",
+	"ter",
+	"teri",
+	"terin",
+	"ti",
+	"tin",
+	"tin_",
+	"tin__",
+	"tn",
+	"tni",
+	"tni_",
+	"tni__",
+	"tu",
+	"tur",
+	"tura",
+	"turab",
+	"tus",
+	"tusu",
+	"tusun",
+	"t\xc3",
+	"tü",
+	"tül",
+	"tüle",
+	"t\xc4",
+	"tı",
+	"tır",
+	"tıra",
+	"tır\xc4",
+	"u",
+	"u_",
+	"u__",
+	"u___",
+	"u____",
+	"ul",
+	"ul_",
+	"ul__",
+	"ul___",
+	"ull",
+	"ulla",
+	"ullan",
+	"un",
+	"un_",
+	"un__",
+	"un___",
+	"una",
+	"una_",
+	"una__",
+	"ur",
+	"ura",
+	"urab",
+	"urabi",
+	"us",
+	"usu",
+	"usun",
+	"usuna",
+	"ut",
+	"utu",
+	"utus",
+	"utusu",
+	"u\xc5",
+	"uş",
+	"uşt",
+	"uştu",
+	"v",
+	"ve",
+	"ve_",
+	"ve__",
+	"ve___",
+	"ven",
+	"venl",
+	"venle",
+	"ver",
+	"veri",
+	"veril",
+	"w",
+	"we",
+	"web",
+	"web_",
+	"web__",
+	"y",
+	"ya",
+	"ya_",
+	"ya__",
+	"ya___",
+	"yan",
+	"yan\xc4",
+	"yanı",
+	"yar",
+	"yara",
+	"yarak",
+	"yaz",
+	"yaz\xc4",
+	"yazı",
+	"ye",
+	"yen",
+	"yeni",
+	"yeni_",
+	"yet",
+	"yete",
+	"yeter",
+	"yf",
+	"yfa",
+	"yfas",
+	"yfas\xc4",
+	"yi",
+	"yi_",
+	"yi__",
+	"yi___",
+	"yl",
+	"yle",
+	"yle_",
+	"yle__",
+	"yn",
+	"yn\xc4",
+	"ynı",
+	"ynı_",
+	"yo",
+	"yo\xc4",
+	"yoğ",
+	"yoğu",
+	"y\xc3",
+	"yü",
+	"yük",
+	"yük_",
+	"z",
+	"z_",
+	"z__",
+	"z___",
+	"z____",
+	"zi",
+	"zil",
+	"zile",
+	"ziler",
+	"zin",
+	"zini",
+	"zini_",
+	"ziy",
+	"ziyl",
+	"ziyle",
+	"z\xc4",
+	"zı",
+	"zıl",
+	"zıla",
+	"zın",
+	"zın\xc4",
+	"\x9f",
+	"\x9fi",
+	"\x9fin",
+	"\x9find",
+	"\x9finde",
+	"\x9fr",
+	"\x9fru",
+	"\x9fru_",
+	"\x9fru__",
+	"\x9ft",
+	"\x9ftu",
+	"\x9ftur",
+	"\x9ftura",
+	"\x9ft\xc4",
+	"\x9ftı",
+	"\x9ftır",
+	"\x9fu",
+	"\x9fun",
+	"\x9fun_",
+	"\x9fun__",
+	"\x9f\xc4",
+	"\x9fı",
+	"\x9fıl",
+	"\x9fıla",
+	"\xa7",
+	"\xa7a",
+	"\xa7as",
+	"\xa7as\xc4",
+	"\xa7ası",
+	"\xa7e",
+	"\xa7er",
+	"\xa7erl",
+	"\xa7erli",
+	"\xa7i",
+	"\xa7in",
+	"\xa7in_",
+	"\xa7in__",
+	"\xa7o",
+	"\xa7ok",
+	"\xa7ok_",
+	"\xa7ok__",
+	"\xa7\xc3",
+	"\xa7ü",
+	"\xa7ük",
+	"\xa7ük_",
+	"\xb0",
+	"\xb0y",
+	"\xb0yi",
+	"\xb0yi_",
+	"\xb0yi__",
+	"\xb1",
+	"\xb1k",
+	"\xb1k_",
+	"\xb1k__",
+	"\xb1k___",
+	"\xb1kl",
+	"\xb1kla",
+	"\xb1kla_",
+	"\xb1kl\xc4",
+	"\xb1klı",
+	"\xb1l",
+	"\xb1la",
+	"\xb1lan",
+	"\xb1lan_",
+	"\xb1lana",
+	"\xb1land",
+	"\xb1la\xc5",
+	"\xb1laş",
+	"\xb1m",
+	"\xb1ma",
+	"\xb1ma_",
+	"\xb1ma__",
+	"\xb1n",
+	"\xb1n\xc4",
+	"\xb1nı",
+	"\xb1nı_",
+	"\xb1nın",
+	"\xb1r",
+	"\xb1r_",
+	"\xb1r__",
+	"\xb1r___",
+	"\xb1ra",
+	"\xb1rab",
+	"\xb1rabi",
+	"\xb1ral",
+	"\xb1rala",
+	"\xb1s",
+	"\xb1sa",
+	"\xb1sa_",
+	"\xb1sa__",
+	"\xb6",
+	"\xb6r",
+	"\xb6rn",
+	"\xb6rne",
+	"\xb6rnek",
+	"\xb6rne\xc4",
+	"\xb6r\xc3",
+	"\xb6rü",
+	"\xb6rün",
+	"\xbc",
+	"\xbcl",
+	"\xbcle",
+	"\xbcler",
+	"\xbcleri",
+	"\xbcm",
+	"\xbcml",
+	"\xbcmle",
+	"\xbcmle_",
+	"\xbcn",
+	"\xbcnl",
+	"\xbcnl\xc3",
+	"\xbcnlü",
+	"\xbcnt",
+	"\xbcnt\xc3",
+	"\xbcntü",
+	"\xbcv",
+	"\xbcve",
+	"\xbcven",
+	"\xbcvenl",
+	"\xbcy",
+	"\xbcy\xc3",
+	"\xbcyü",
+	"\xbcyük",
+	"\xbc\xc3",
+	"\xbcç",
+	"\xbcç\xc3",
+	"\xbcçü",
+	"\xc3",
+	"ç",
+	"ça",
+	"ças",
+	"ças\xc4",
+	"çe",
+	"çer",
+	"çerl",
+	"çi",
+	"çin",
+	"çin_",
+	"ço",
+	"çok",
+	"çok_",
+	"ç\xc3",
+	"çü",
+	"çük",
+	"ö",
+	"ör",
+	"örn",
+	"örne",
+	"ör\xc3",
+	"örü",
+	"ü",
+	"ük",
+	"ük_",
+	"ük__",
+	"ül",
+	"üle",
+	"üler",
+	"üm",
+	"üml",
+	"ümle",
+	"ün",
+	"ünl",
+	"ünl\xc3",
+	"ünt",
+	"ünt\xc3",
+	"üv",
+	"üve",
+	"üven",
+	"üy",
+	"üy\xc3",
+	"üyü",
+	"ü\xc3",
+	"üç",
+	"üç\xc3",
+	"\xc4",
+	"ğ",
+	"ği",
+	"ğin",
+	"ğind",
+	"ğr",
+	"ğru",
+	"ğru_",
+	"ğu",
+	"ğun",
+	"ğun_",
+	"İ",
+	"İy",
+	"İyi",
+	"İyi_",
+	"ı",
+	"ı_",
+	"ı__",
+	"ı___",
+	"ık",
+	"ık_",
+	"ık__",
+	"ıkl",
+	"ıkla",
+	"ıkl\xc4",
+	"ıl",
+	"ıla",
+	"ılan",
+	"ıla\xc5",
+	"ım",
+	"ıma",
+	"ıma_",
+	"ın",
+	"ın_",
+	"ın__",
+	"ın\xc4",
+	"ını",
+	"ır",
+	"ır_",
+	"ır__",
+	"ıra",
+	"ırab",
+	"ıral",
+	"ır\xc4",
+	"ırı",
+	"ıs",
+	"ısa",
+	"ısa_",
+	"\xc5",
+	"ş",
+	"şt",
+	"ştu",
+	"ştur",
+	"şt\xc4",
+	"ştı",
+	"ş\xc4",
+	"şı",
+	"şıl",
+}
+
+var ranks = []uint16{
+	1605,
+	1604,
+	1603,
+	1602,
+	1601,
+	1600,
+	1599,
+	1598,
+	1597,
+	1596,
+	1595,
+	1594,
+	1593,
+	1592,
+	1591,
+	1590,
+	1589,
+	1588,
+	1587,
+	1586,
+	1585,
+	1584,
+	1583,
+	1582,
+	1581,
+	1580,
+	1579,
+	1578,
+	1577,
+	1576,
+	1575,
+	1574,
+	1573,
+	1572,
+	1571,
+	1570,
+	1569,
+	1568,
+	1567,
+	1566,
+	1565,
+	1564,
+	1563,
+	1562,
+	1561,
+	301,
+	16,
+	1560,
+	58,
+	1559,
+	1558,
+	132,
+	620,
+	131,
+	48,
+	300,
+	619,
+	1557,
+	176,
+	73,
+	175,
+	130,
+	1556,
+	90,
+	174,
+	1555,
+	299,
+	1554,
+	1553,
+	1552,
+	15,
+	31,
+	618,
+	1551,
+	1550,
+	1549,
+	57,
+	298,
+	1548,
+	129,
+	1547,
+	1546,
+	1545,
+	1544,
+	1543,
+	128,
+	1542,
+	617,
+	616,
+	615,
+	614,
+	127,
+	1541,
+	613,
+	612,
+	47,
+	297,
+	1540,
+	296,
+	1539,
+	295,
+	294,
+	1538,
+	611,
+	610,
+	609,
+	1537,
+	1536,
+	173,
+	293,
+	1535,
+	72,
+	1534,
+	608,
+	1533,
+	292,
+	172,
+	607,
+	606,
+	126,
+	125,
+	1532,
+	1531,
+	89,
+	291,
+	605,
+	1530,
+	171,
+	1529,
+	290,
+	1528,
+	1527,
+	289,
+	1526,
+	1525,
+	1524,
+	1523,
+	1522,
+	1521,
+	14,
+	30,
+	604,
+	37,
+	603,
+	602,
+	1520,
+	1519,
+	1518,
+	1517,
+	1516,
+	56,
+	288,
+	1515,
+	1514,
+	1513,
+	1512,
+	1511,
+	124,
+	287,
+	601,
+	1510,
+	1509,
+	1508,
+	1507,
+	1506,
+	1505,
+	1504,
+	1503,
+	123,
+	1502,
+	1501,
+	600,
+	599,
+	598,
+	597,
+	596,
+	595,
+	594,
+	122,
+	1500,
+	1499,
+	593,
+	592,
+	591,
+	590,
+	46,
+	286,
+	285,
+	1498,
+	1497,
+	284,
+	589,
+	1496,
+	1495,
+	1494,
+	283,
+	282,
+	281,
+	1493,
+	1492,
+	588,
+	587,
+	586,
+	585,
+	584,
+	1491,
+	1490,
+	1489,
+	170,
+	280,
+	279,
+	1488,
+	1487,
+	71,
+	1486,
+	1485,
+	583,
+	582,
+	1484,
+	1483,
+	278,
+	277,
+	169,
+	581,
+	580,
+	579,
+	578,
+	121,
+	120,
+	168,
+	1482,
+	1481,
+	1480,
+	1479,
+	88,
+	276,
+	1478,
+	577,
+	576,
+	1477,
+	1476,
+	1475,
+	1474,
+	167,
+	1473,
+	1472,
+	275,
+	274,
+	1471,
+	1470,
+	1469,
+	273,
+	1468,
+	1467,
+	1466,
+	1465,
+	1464,
+	1463,
+	1462,
+	1461,
+	1460,
+	13,
+	29,
+	575,
+	1459,
+	1458,
+	36,
+	42,
+	1457,
+	574,
+	573,
+	572,
+	1456,
+	1455,
+	1454,
+	1453,
+	1452,
+	1451,
+	1450,
+	55,
+	272,
+	1449,
+	1448,
+	1447,
+	1446,
+	1445,
+	1444,
+	1443,
+	1442,
+	1441,
+	119,
+	271,
+	571,
+	1440,
+	570,
+	569,
+	1439,
+	1438,
+	1437,
+	1436,
+	1435,
+	1434,
+	1433,
+	1432,
+	1431,
+	1430,
+	1429,
+	118,
+	1428,
+	1427,
+	1426,
+	568,
+	567,
+	566,
+	565,
+	564,
+	1425,
+	1424,
+	563,
+	562,
+	561,
+	560,
+	117,
+	1423,
+	1422,
+	1421,
+	559,
+	558,
+	1420,
+	1419,
+	557,
+	556,
+	555,
+	45,
+	270,
+	269,
+	1418,
+	554,
+	1417,
+	1416,
+	1415,
+	268,
+	553,
+	552,
+	1414,
+	1413,
+	1412,
+	1411,
+	1410,
+	267,
+	266,
+	265,
+	264,
+	1409,
+	1408,
+	1407,
+	551,
+	550,
+	1406,
+	1405,
+	549,
+	548,
+	547,
+	546,
+	1404,
+	1403,
+	1402,
+	1401,
+	166,
+	263,
+	262,
+	545,
+	1400,
+	1399,
+	1398,
+	1397,
+	70,
+	1396,
+	1395,
+	1394,
+	544,
+	543,
+	542,
+	1393,
+	1392,
+	1391,
+	261,
+	260,
+	541,
+	1390,
+	165,
+	540,
+	539,
+	538,
+	537,
+	536,
+	1389,
+	1388,
+	116,
+	115,
+	164,
+	163,
+	1387,
+	1386,
+	1385,
+	1384,
+	1383,
+	1382,
+	87,
+	259,
+	1381,
+	1380,
+	535,
+	534,
+	533,
+	1379,
+	1378,
+	1377,
+	1376,
+	1375,
+	1374,
+	1373,
+	162,
+	1372,
+	1371,
+	1370,
+	258,
+	257,
+	532,
+	1369,
+	1368,
+	1367,
+	1366,
+	1365,
+	2,
+	86,
+	85,
+	84,
+	83,
+	531,
+	530,
+	529,
+	528,
+	1364,
+	1363,
+	1362,
+	1361,
+	1360,
+	1359,
+	1358,
+	1357,
+	161,
+	256,
+	255,
+	254,
+	1356,
+	1355,
+	1354,
+	1353,
+	1352,
+	1351,
+	1350,
+	253,
+	1349,
+	1348,
+	1347,
+	1346,
+	1345,
+	1344,
+	1343,
+	1342,
+	1341,
+	61,
+	527,
+	526,
+	525,
+	1340,
+	1339,
+	1338,
+	1337,
+	1336,
+	1335,
+	114,
+	113,
+	524,
+	523,
+	1334,
+	1333,
+	1332,
+	1331,
+	1330,
+	35,
+	1329,
+	1328,
+	1327,
+	252,
+	1326,
+	1325,
+	1324,
+	1323,
+	1322,
+	1321,
+	522,
+	521,
+	520,
+	1320,
+	1319,
+	1318,
+	519,
+	518,
+	517,
+	1317,
+	1316,
+	1315,
+	1314,
+	1313,
+	1312,
+	516,
+	515,
+	514,
+	513,
+	512,
+	511,
+	1311,
+	1310,
+	251,
+	510,
+	1309,
+	1308,
+	1307,
+	1306,
+	1305,
+	1304,
+	1303,
+	250,
+	1302,
+	1301,
+	1300,
+	509,
+	508,
+	1299,
+	1298,
+	507,
+	506,
+	505,
+	504,
+	9,
+	1297,
+	1296,
+	1295,
+	1294,
+	21,
+	160,
+	1293,
+	1292,
+	249,
+	1291,
+	503,
+	34,
+	41,
+	40,
+	1290,
+	1289,
+	502,
+	501,
+	500,
+	499,
+	1288,
+	1287,
+	1286,
+	1285,
+	248,
+	498,
+	1284,
+	1283,
+	1282,
+	1281,
+	1280,
+	1279,
+	1278,
+	1277,
+	1276,
+	1275,
+	28,
+	159,
+	1274,
+	1273,
+	1272,
+	1271,
+	1270,
+	1269,
+	1268,
+	1267,
+	1266,
+	1265,
+	1264,
+	1263,
+	247,
+	497,
+	496,
+	495,
+	1262,
+	1261,
+	1260,
+	82,
+	246,
+	494,
+	493,
+	1259,
+	1258,
+	1257,
+	1256,
+	1255,
+	492,
+	491,
+	490,
+	1254,
+	1253,
+	1252,
+	1251,
+	1250,
+	1249,
+	1248,
+	1247,
+	4,
+	54,
+	53,
+	52,
+	51,
+	1246,
+	1245,
+	1244,
+	1243,
+	1242,
+	1241,
+	1240,
+	1239,
+	245,
+	1238,
+	1237,
+	1236,
+	1235,
+	1234,
+	1233,
+	1232,
+	1231,
+	1230,
+	1229,
+	1228,
+	1227,
+	1226,
+	489,
+	1225,
+	1224,
+	1223,
+	1222,
+	1221,
+	1220,
+	158,
+	488,
+	487,
+	486,
+	1219,
+	1218,
+	1217,
+	1216,
+	1215,
+	1214,
+	50,
+	244,
+	243,
+	242,
+	112,
+	1213,
+	1212,
+	157,
+	1211,
+	1210,
+	1209,
+	1208,
+	485,
+	1207,
+	1206,
+	1205,
+	1204,
+	241,
+	1203,
+	1202,
+	1201,
+	1200,
+	1199,
+	1198,
+	1197,
+	1196,
+	1195,
+	1194,
+	1193,
+	1192,
+	1191,
+	1190,
+	1189,
+	1188,
+	1187,
+	111,
+	484,
+	483,
+	482,
+	481,
+	1186,
+	1185,
+	1184,
+	1183,
+	480,
+	1182,
+	1181,
+	1180,
+	1179,
+	1178,
+	1177,
+	110,
+	1176,
+	1175,
+	1174,
+	1173,
+	479,
+	478,
+	477,
+	1172,
+	1171,
+	476,
+	475,
+	1170,
+	1169,
+	1168,
+	1167,
+	240,
+	239,
+	1166,
+	1165,
+	1164,
+	474,
+	473,
+	472,
+	1,
+	156,
+	155,
+	154,
+	153,
+	1163,
+	1162,
+	1161,
+	1160,
+	1159,
+	1158,
+	1157,
+	1156,
+	44,
+	238,
+	237,
+	236,
+	152,
+	1155,
+	1154,
+	1153,
+	1152,
+	471,
+	1151,
+	1150,
+	235,
+	470,
+	1149,
+	1148,
+	1147,
+	1146,
+	1145,
+	1144,
+	1143,
+	43,
+	151,
+	150,
+	149,
+	469,
+	468,
+	1142,
+	1141,
+	467,
+	466,
+	465,
+	464,
+	1140,
+	1139,
+	1138,
+	1137,
+	1136,
+	1135,
+	1134,
+	22,
+	27,
+	26,
+	25,
+	1133,
+	1132,
+	1131,
+	463,
+	462,
+	461,
+	460,
+	1130,
+	1129,
+	1128,
+	1127,
+	1126,
+	1125,
+	1124,
+	1123,
+	148,
+	147,
+	459,
+	458,
+	1122,
+	1121,
+	1120,
+	1119,
+	457,
+	456,
+	455,
+	454,
+	10,
+	234,
+	233,
+	232,
+	231,
+	230,
+	229,
+	1118,
+	1117,
+	453,
+	452,
+	451,
+	1116,
+	1115,
+	1114,
+	1113,
+	1112,
+	1111,
+	228,
+	1110,
+	1109,
+	1108,
+	1107,
+	1106,
+	1105,
+	1104,
+	1103,
+	1102,
+	1101,
+	1100,
+	1099,
+	1098,
+	146,
+	227,
+	1097,
+	1096,
+	450,
+	449,
+	1095,
+	1094,
+	1093,
+	1092,
+	1091,
+	1090,
+	1089,
+	226,
+	225,
+	224,
+	223,
+	5,
+	145,
+	144,
+	143,
+	142,
+	60,
+	109,
+	1088,
+	1087,
+	1086,
+	1085,
+	1084,
+	1083,
+	448,
+	447,
+	1082,
+	1081,
+	1080,
+	1079,
+	1078,
+	1077,
+	446,
+	445,
+	444,
+	39,
+	108,
+	107,
+	106,
+	1076,
+	1075,
+	1074,
+	81,
+	443,
+	442,
+	222,
+	221,
+	1073,
+	1072,
+	141,
+	1071,
+	1070,
+	1069,
+	441,
+	1068,
+	1067,
+	1066,
+	1065,
+	1064,
+	1063,
+	1062,
+	220,
+	440,
+	439,
+	438,
+	1061,
+	1060,
+	1059,
+	1058,
+	1057,
+	1056,
+	1055,
+	1054,
+	1053,
+	1052,
+	1051,
+	437,
+	436,
+	1050,
+	1049,
+	1048,
+	1047,
+	33,
+	435,
+	434,
+	433,
+	432,
+	80,
+	219,
+	218,
+	217,
+	216,
+	431,
+	430,
+	1046,
+	1045,
+	429,
+	428,
+	1044,
+	1043,
+	1042,
+	1041,
+	1040,
+	1039,
+	1038,
+	1037,
+	427,
+	1036,
+	1035,
+	1034,
+	1033,
+	1032,
+	1031,
+	6,
+	79,
+	78,
+	77,
+	76,
+	426,
+	425,
+	424,
+	423,
+	422,
+	421,
+	1030,
+	1029,
+	1028,
+	1027,
+	215,
+	1026,
+	1025,
+	1024,
+	420,
+	419,
+	418,
+	140,
+	1023,
+	1022,
+	1021,
+	1020,
+	1019,
+	1018,
+	1017,
+	1016,
+	1015,
+	1014,
+	1013,
+	1012,
+	214,
+	213,
+	212,
+	211,
+	417,
+	1011,
+	1010,
+	1009,
+	1008,
+	1007,
+	1006,
+	1005,
+	1004,
+	1003,
+	1002,
+	1001,
+	1000,
+	999,
+	998,
+	69,
+	68,
+	997,
+	996,
+	416,
+	415,
+	414,
+	413,
+	995,
+	994,
+	993,
+	992,
+	67,
+	991,
+	990,
+	989,
+	988,
+	412,
+	411,
+	410,
+	409,
+	987,
+	986,
+	985,
+	984,
+	983,
+	982,
+	981,
+	980,
+	408,
+	407,
+	979,
+	978,
+	977,
+	976,
+	105,
+	975,
+	974,
+	973,
+	972,
+	210,
+	209,
+	406,
+	405,
+	971,
+	970,
+	969,
+	968,
+	967,
+	966,
+	3,
+	20,
+	19,
+	18,
+	17,
+	49,
+	965,
+	964,
+	963,
+	404,
+	403,
+	402,
+	962,
+	961,
+	960,
+	959,
+	958,
+	957,
+	208,
+	956,
+	955,
+	954,
+	953,
+	952,
+	951,
+	401,
+	950,
+	949,
+	948,
+	947,
+	400,
+	399,
+	398,
+	397,
+	104,
+	946,
+	945,
+	944,
+	139,
+	943,
+	942,
+	941,
+	940,
+	939,
+	938,
+	937,
+	936,
+	207,
+	935,
+	934,
+	933,
+	932,
+	931,
+	930,
+	929,
+	928,
+	927,
+	396,
+	395,
+	394,
+	393,
+	392,
+	391,
+	926,
+	925,
+	924,
+	923,
+	922,
+	921,
+	920,
+	919,
+	918,
+	917,
+	916,
+	915,
+	206,
+	390,
+	914,
+	913,
+	912,
+	911,
+	910,
+	909,
+	908,
+	907,
+	906,
+	905,
+	904,
+	389,
+	388,
+	387,
+	386,
+	24,
+	138,
+	205,
+	204,
+	203,
+	903,
+	902,
+	901,
+	385,
+	384,
+	383,
+	382,
+	900,
+	899,
+	898,
+	897,
+	381,
+	380,
+	379,
+	896,
+	895,
+	894,
+	893,
+	892,
+	891,
+	103,
+	102,
+	890,
+	889,
+	378,
+	888,
+	887,
+	886,
+	885,
+	884,
+	883,
+	23,
+	202,
+	377,
+	376,
+	375,
+	882,
+	881,
+	880,
+	101,
+	374,
+	879,
+	878,
+	877,
+	876,
+	373,
+	875,
+	874,
+	873,
+	872,
+	871,
+	870,
+	869,
+	868,
+	867,
+	866,
+	865,
+	864,
+	863,
+	862,
+	861,
+	372,
+	860,
+	859,
+	858,
+	857,
+	856,
+	855,
+	854,
+	853,
+	852,
+	851,
+	371,
+	370,
+	369,
+	850,
+	849,
+	38,
+	201,
+	200,
+	199,
+	198,
+	197,
+	848,
+	847,
+	846,
+	368,
+	367,
+	366,
+	365,
+	845,
+	844,
+	843,
+	842,
+	841,
+	840,
+	839,
+	838,
+	837,
+	836,
+	835,
+	834,
+	833,
+	832,
+	831,
+	830,
+	829,
+	828,
+	827,
+	826,
+	825,
+	824,
+	75,
+	74,
+	137,
+	136,
+	135,
+	823,
+	822,
+	821,
+	820,
+	819,
+	818,
+	817,
+	816,
+	815,
+	814,
+	813,
+	32,
+	100,
+	812,
+	811,
+	810,
+	809,
+	808,
+	807,
+	806,
+	805,
+	804,
+	364,
+	363,
+	362,
+	361,
+	803,
+	802,
+	801,
+	800,
+	799,
+	798,
+	797,
+	796,
+	795,
+	794,
+	793,
+	792,
+	791,
+	790,
+	789,
+	788,
+	787,
+	786,
+	785,
+	784,
+	783,
+	782,
+	781,
+	780,
+	779,
+	778,
+	777,
+	776,
+	775,
+	774,
+	66,
+	773,
+	772,
+	771,
+	770,
+	134,
+	360,
+	359,
+	358,
+	769,
+	768,
+	767,
+	766,
+	765,
+	764,
+	357,
+	356,
+	763,
+	762,
+	761,
+	760,
+	62,
+	759,
+	758,
+	757,
+	756,
+	755,
+	754,
+	753,
+	752,
+	196,
+	751,
+	750,
+	749,
+	355,
+	354,
+	353,
+	748,
+	747,
+	746,
+	745,
+	352,
+	351,
+	350,
+	349,
+	65,
+	744,
+	743,
+	742,
+	741,
+	740,
+	739,
+	738,
+	737,
+	348,
+	347,
+	346,
+	345,
+	344,
+	343,
+	342,
+	341,
+	736,
+	735,
+	734,
+	733,
+	732,
+	731,
+	730,
+	729,
+	728,
+	12,
+	195,
+	727,
+	726,
+	725,
+	340,
+	724,
+	723,
+	722,
+	721,
+	99,
+	98,
+	194,
+	720,
+	719,
+	718,
+	339,
+	338,
+	337,
+	336,
+	335,
+	334,
+	333,
+	332,
+	331,
+	717,
+	716,
+	193,
+	715,
+	714,
+	713,
+	330,
+	712,
+	711,
+	710,
+	709,
+	192,
+	191,
+	190,
+	189,
+	188,
+	187,
+	329,
+	328,
+	708,
+	707,
+	706,
+	705,
+	704,
+	64,
+	703,
+	702,
+	701,
+	700,
+	699,
+	698,
+	697,
+	696,
+	327,
+	695,
+	694,
+	693,
+	692,
+	691,
+	690,
+	689,
+	688,
+	687,
+	686,
+	685,
+	684,
+	683,
+	682,
+	681,
+	680,
+	679,
+	678,
+	11,
+	63,
+	677,
+	676,
+	675,
+	674,
+	673,
+	672,
+	326,
+	325,
+	324,
+	323,
+	322,
+	321,
+	671,
+	670,
+	669,
+	186,
+	185,
+	320,
+	319,
+	668,
+	667,
+	59,
+	318,
+	317,
+	316,
+	666,
+	665,
+	664,
+	663,
+	662,
+	661,
+	315,
+	660,
+	659,
+	658,
+	657,
+	656,
+	655,
+	654,
+	653,
+	652,
+	651,
+	650,
+	649,
+	648,
+	7,
+	184,
+	647,
+	646,
+	645,
+	644,
+	643,
+	642,
+	641,
+	640,
+	639,
+	638,
+	637,
+	636,
+	635,
+	8,
+	97,
+	96,
+	95,
+	183,
+	634,
+	633,
+	314,
+	632,
+	631,
+	94,
+	93,
+	182,
+	313,
+	312,
+	311,
+	310,
+	181,
+	630,
+	629,
+	309,
+	308,
+	133,
+	628,
+	627,
+	307,
+	626,
+	625,
+	624,
+	623,
+	180,
+	179,
+	178,
+	92,
+	91,
+	177,
+	622,
+	621,
+	306,
+	305,
+	304,
+	303,
+	302,
+}
+
+// Profile is the pre-built turkish language profile.
+var Profile = langdet.Language{
+	Name:    "turkish",
+	Profile: langdet.BuildProfile(tokens, ranks),
+	Tag:     language.MustParse("tr"),
+	Scripts: []langdet.Script{langdet.ScriptLatin},
+}
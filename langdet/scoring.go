@@ -0,0 +1,162 @@
+package langdet
+
+import (
+	"math"
+	"sort"
+)
+
+// Scorer rates how similar a sample rank map is to a language profile rank map; both are the
+// map[token]rank produced by CreateRankLookupMap. Higher means more similar. Detector.Scorer lets
+// callers plug in an alternative to the Cavnar-Trenkle metric Language.CompareTo uses by default.
+type Scorer interface {
+	Score(profile, sample map[string]int) float64
+}
+
+// DefaultScorer is the Scorer Language.CompareTo has always used, exposed so Detector.Scorer can
+// be reset to it, or composed with, explicitly.
+var DefaultScorer Scorer = CavnarTrenkleScorer{}
+
+// CavnarTrenkleScorer implements the classic Cavnar-Trenkle out-of-place rank-distance metric:
+// for every n-gram of sample ranked at or below RankCutoff, it adds the absolute rank difference
+// to profile's rank for that n-gram, capping the difference - and counting any n-gram missing
+// from profile - at MaxDistance. The result is normalized into a 0..1 similarity score.
+//
+// RankCutoff defaults to 300 and MaxDistance defaults to len(profile) when left at the zero
+// value, matching every prior version of this package.
+type CavnarTrenkleScorer struct {
+	RankCutoff  int
+	MaxDistance int
+}
+
+// Score implements Scorer.
+func (s CavnarTrenkleScorer) Score(profile, sample map[string]int) float64 {
+	if len(profile) == 0 || len(sample) == 0 {
+		return 0
+	}
+	rankCutoff := s.RankCutoff
+	if rankCutoff <= 0 {
+		rankCutoff = 300
+	}
+	maxDistance := s.MaxDistance
+	if maxDistance <= 0 {
+		maxDistance = len(profile)
+	}
+
+	dist := getDistanceWithCutoff(sample, profile, maxDistance, rankCutoff)
+	maxPossibleDistance := len(profile) * len(sample)
+	return 1 - float64(dist)/float64(maxPossibleDistance)
+}
+
+// CosineScorer scores similarity as the cosine similarity between the top-K n-grams of profile and
+// sample. profile and sample are rank maps (lower rank means more frequent), not occurrence
+// counts, so there is no raw term frequency to use directly; each n-gram is instead weighted by
+// 1/(rank+1), a log-TF-like stand-in that favors the same highest-frequency n-grams a TF vector
+// would. If TopK is 0, every n-gram is used.
+type CosineScorer struct {
+	TopK int
+}
+
+// Score implements Scorer.
+func (s CosineScorer) Score(profile, sample map[string]int) float64 {
+	profileWeights := rankWeights(profile, s.TopK)
+	sampleWeights := rankWeights(sample, s.TopK)
+
+	var dot, profileNorm, sampleNorm float64
+	for token, pw := range profileWeights {
+		profileNorm += pw * pw
+		if sw, ok := sampleWeights[token]; ok {
+			dot += pw * sw
+		}
+	}
+	for _, sw := range sampleWeights {
+		sampleNorm += sw * sw
+	}
+	if profileNorm == 0 || sampleNorm == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(profileNorm) * math.Sqrt(sampleNorm))
+}
+
+// KLDivergenceScorer scores similarity between smoothed frequency distributions derived from
+// profile's and sample's rank weights (see rankWeights), with add-one smoothing so an n-gram
+// missing from either side doesn't produce a zero probability. The raw Kullback-Leibler
+// divergence is unbounded and 0 means "identical", the opposite of Scorer's "higher is more
+// similar" convention, so Score returns 1/(1+KL): it stays in (0, 1], with 1 meaning identical
+// distributions, matching the range calibrateConfidence's gap formula assumes of every Scorer.
+type KLDivergenceScorer struct{}
+
+// Score implements Scorer.
+func (s KLDivergenceScorer) Score(profile, sample map[string]int) float64 {
+	profileWeights := rankWeights(profile, 0)
+	sampleWeights := rankWeights(sample, 0)
+
+	vocab := make(map[string]struct{}, len(profileWeights)+len(sampleWeights))
+	for token := range profileWeights {
+		vocab[token] = struct{}{}
+	}
+	for token := range sampleWeights {
+		vocab[token] = struct{}{}
+	}
+
+	var profileTotal, sampleTotal float64
+	for token := range vocab {
+		profileTotal += profileWeights[token] + 1
+		sampleTotal += sampleWeights[token] + 1
+	}
+
+	var kl float64
+	for token := range vocab {
+		p := (sampleWeights[token] + 1) / sampleTotal
+		q := (profileWeights[token] + 1) / profileTotal
+		kl += p * math.Log(p/q)
+	}
+	return 1 / (1 + kl)
+}
+
+// rankWeights turns a rank map into token->weight, weighting each token by 1/(rank+1) and keeping
+// only the topK lowest (best) ranks when topK > 0.
+func rankWeights(ranks map[string]int, topK int) map[string]float64 {
+	type rankedToken struct {
+		token string
+		rank  int
+	}
+	tokens := make([]rankedToken, 0, len(ranks))
+	for token, rank := range ranks {
+		tokens = append(tokens, rankedToken{token, rank})
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].rank < tokens[j].rank })
+	if topK > 0 && topK < len(tokens) {
+		tokens = tokens[:topK]
+	}
+
+	weights := make(map[string]float64, len(tokens))
+	for _, t := range tokens {
+		weights[t.token] = 1 / float64(t.rank+1)
+	}
+	return weights
+}
+
+// getDistanceWithCutoff is GetDistance generalized over the rank cutoff, which GetDistance itself
+// hard-codes at 300 for back-compat.
+func getDistanceWithCutoff(mapA, mapB map[string]int, maxDist, rankCutoff int) int {
+	var result int
+	negMaxDist := (-1) * maxDist
+	for key, rankA := range mapA {
+		if rankA > rankCutoff {
+			continue
+		}
+		var diff int
+		if rankB, ok := mapB[key]; ok {
+			diff = rankB - rankA
+			if diff > maxDist || diff < negMaxDist {
+				diff = maxDist
+			} else if diff < 0 {
+				diff = diff * (-1)
+			}
+		} else {
+			diff = maxDist
+		}
+		result += diff
+	}
+	return result
+}
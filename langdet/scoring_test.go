@@ -0,0 +1,109 @@
+package langdet_test
+
+import (
+	"testing"
+
+	"github.com/chrisport/go-lang-detector/langdet"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCavnarTrenkleScorer(t *testing.T) {
+	Convey("Subject: Test CavnarTrenkleScorer", t, func() {
+		Convey("identical profiles should score 1", func() {
+			profile := createMapRanking("a", "b", "c")
+			score := langdet.CavnarTrenkleScorer{}.Score(profile, profile)
+			So(score, ShouldEqual, float64(1))
+		})
+
+		Convey("entirely different profiles should score lower than identical ones", func() {
+			profile := createMapRanking("a", "b", "c")
+			sample := createMapRanking("e", "f", "g")
+			score := langdet.CavnarTrenkleScorer{}.Score(profile, sample)
+			So(score, ShouldBeLessThan, 1)
+		})
+	})
+}
+
+func TestCosineScorer(t *testing.T) {
+	Convey("Subject: Test CosineScorer", t, func() {
+		Convey("identical profiles should score 1", func() {
+			profile := createMapRanking("a", "b", "c")
+			score := langdet.CosineScorer{}.Score(profile, profile)
+			So(score, ShouldAlmostEqual, float64(1))
+		})
+
+		Convey("disjoint profiles should score 0", func() {
+			profile := createMapRanking("a", "b", "c")
+			sample := createMapRanking("e", "f", "g")
+			score := langdet.CosineScorer{}.Score(profile, sample)
+			So(score, ShouldEqual, float64(0))
+		})
+	})
+}
+
+func TestKLDivergenceScorer(t *testing.T) {
+	Convey("Subject: Test KLDivergenceScorer", t, func() {
+		Convey("identical profiles should score 1", func() {
+			profile := createMapRanking("a", "b", "c")
+			score := langdet.KLDivergenceScorer{}.Score(profile, profile)
+			So(score, ShouldAlmostEqual, float64(1))
+		})
+
+		Convey("entirely different profiles should score lower than identical ones, but stay positive", func() {
+			profile := createMapRanking("a", "b", "c")
+			sample := createMapRanking("e", "f", "g")
+			score := langdet.KLDivergenceScorer{}.Score(profile, sample)
+			So(score, ShouldBeLessThan, 1)
+			So(score, ShouldBeGreaterThan, 0)
+		})
+	})
+}
+
+func TestDetectorWithScorer(t *testing.T) {
+	Convey("Subject: Test Detector with a configured Scorer", t, func() {
+		s := "Hello I am english text, what is your language? I really dont know you say?"
+		d := langdet.NewDetector()
+		d.Scorer = langdet.CosineScorer{}
+		// The calibrated confidence gap between english and french for this scorer, on this short
+		// sample, is smaller than the raw-distance percentages DefaultMinimumConfidence was tuned
+		// against, so this Convey lowers it to demonstrate the threshold is meant to be re-tuned
+		// per Scorer rather than reused as a magic constant.
+		d.MinimumConfidence = 0.4
+		d.AddLanguageFromText(s, "english")
+		d.AddLanguageFromText("Je parles français et toi?", "french")
+
+		Convey("Should still find the closest language and populate Score on the results", func() {
+			res := d.GetLanguages(s)
+			So(len(res), ShouldEqual, 2)
+			So(res[0].Name, ShouldEqual, "english")
+			So(res[0].Score, ShouldBeGreaterThan, 0)
+
+			closest := d.GetClosestLanguage(s)
+			So(closest, ShouldEqual, "english")
+		})
+	})
+
+	Convey("Subject: Test Detector with KLDivergenceScorer", t, func() {
+		s := "Hello I am english text, what is your language? I really dont know you say?"
+		d := langdet.NewDetector()
+		d.Scorer = langdet.KLDivergenceScorer{}
+		// KLDivergenceScorer's add-one smoothing washes out a lot of the distance between large,
+		// mostly-overlapping smoothed vocabularies, so the calibrated gap between a good and a bad
+		// candidate is tiny here; MinimumConfidence is lowered accordingly rather than left at
+		// DefaultMinimumConfidence, which was tuned for the raw-distance percentages of the default
+		// Cavnar-Trenkle scorer.
+		d.MinimumConfidence = 0.0001
+		d.AddLanguageFromText(s, "english")
+		d.AddLanguageFromText("Je parles français et toi?", "french")
+
+		Convey("Should rank the matching language first with a positive, non-flipped Confidence", func() {
+			res := d.GetLanguages(s)
+			So(len(res), ShouldEqual, 2)
+			So(res[0].Name, ShouldEqual, "english")
+			So(res[0].Confidence, ShouldBeGreaterThanOrEqualTo, 0)
+
+			closest := d.GetClosestLanguage(s)
+			So(closest, ShouldEqual, "english")
+		})
+	})
+}
@@ -0,0 +1,98 @@
+package langdet
+
+import "unicode"
+
+// Script identifies a Unicode script block. It is used to tag a Language with the scripts its
+// text is expected to be written in, so closestFromTable can skip comparators that can't possibly
+// match before spending time on n-gram comparison.
+type Script string
+
+// Scripts with dedicated detection support. Not exhaustive: any name out of unicode.Scripts could
+// be added here as the need arises.
+const (
+	ScriptLatin      Script = "Latin"
+	ScriptCyrillic   Script = "Cyrillic"
+	ScriptArabic     Script = "Arabic"
+	ScriptHebrew     Script = "Hebrew"
+	ScriptHan        Script = "Han"
+	ScriptHiragana   Script = "Hiragana"
+	ScriptKatakana   Script = "Katakana"
+	ScriptHangul     Script = "Hangul"
+	ScriptDevanagari Script = "Devanagari"
+)
+
+var scriptRanges = map[Script]*unicode.RangeTable{
+	ScriptLatin:      unicode.Latin,
+	ScriptCyrillic:   unicode.Cyrillic,
+	ScriptArabic:     unicode.Arabic,
+	ScriptHebrew:     unicode.Hebrew,
+	ScriptHan:        unicode.Han,
+	ScriptHiragana:   unicode.Hiragana,
+	ScriptKatakana:   unicode.Katakana,
+	ScriptHangul:     unicode.Hangul,
+	ScriptDevanagari: unicode.Devanagari,
+}
+
+// exclusiveScripts maps a script to the name of the only Language that can plausibly produce it.
+// When such a script dominates the input, Detector can answer immediately instead of running the
+// n-gram comparison, provided a LanguageComparator with that name is actually registered.
+var exclusiveScripts = map[Script]string{
+	ScriptHangul:   "korean",
+	ScriptHiragana: "japanese",
+	ScriptKatakana: "japanese",
+}
+
+// defaultScripts maps the name of each bundled default Language to the scripts its text is
+// expected to use, mirroring defaultTags in tag.go.
+var defaultScripts = map[string][]Script{
+	"english": {ScriptLatin},
+	"german":  {ScriptLatin},
+	"french":  {ScriptLatin},
+	"turkish": {ScriptLatin},
+	"arabic":  {ScriptArabic},
+	"hebrew":  {ScriptHebrew},
+	"russian": {ScriptCyrillic},
+}
+
+// scriptCounts returns, for every Script with detection support, how many runes of text belong to
+// it. Runes that don't belong to any tracked script (whitespace, punctuation, digits, ...) are not
+// counted.
+func scriptCounts(text string) map[Script]int {
+	counts := make(map[Script]int)
+	for _, r := range text {
+		for script, table := range scriptRanges {
+			if unicode.Is(table, r) {
+				counts[script]++
+			}
+		}
+	}
+	return counts
+}
+
+// dominantScript returns the Script with the highest rune count and the total number of
+// script-tagged runes seen. If no rune belongs to a tracked script, it returns ("", 0).
+func dominantScript(counts map[Script]int) (Script, int) {
+	var best Script
+	var bestCount, total int
+	for script, count := range counts {
+		total += count
+		if count > bestCount {
+			best, bestCount = script, count
+		}
+	}
+	return best, total
+}
+
+// compatibleWithScript reports whether a LanguageComparator without script restrictions, or one
+// whose configured scripts include the dominant script, can possibly match the input.
+func compatibleWithScript(scripts []Script, dominant Script) bool {
+	if len(scripts) == 0 {
+		return true
+	}
+	for _, s := range scripts {
+		if s == dominant {
+			return true
+		}
+	}
+	return false
+}
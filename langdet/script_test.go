@@ -0,0 +1,48 @@
+package langdet_test
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestScriptPrefilter(t *testing.T) {
+	Convey("Subject: Test script-based prefilter", t, func() {
+		d := langdet.NewDetector()
+		d.AddLanguage(langdet.Language{
+			Name:    "russian",
+			Profile: map[string]int{"п": 1},
+			Scripts: []langdet.Script{langdet.ScriptCyrillic},
+		})
+		d.AddLanguage(langdet.Language{
+			Name:    "english",
+			Profile: map[string]int{"e": 1},
+			Scripts: []langdet.Script{langdet.ScriptLatin},
+		})
+
+		Convey("Cyrillic text should only be compared against Cyrillic-tagged languages", func() {
+			res := d.GetLanguages("привет")
+			So(len(res), ShouldEqual, 1)
+			So(res[0].Name, ShouldEqual, "russian")
+		})
+
+		Convey("Latin text should only be compared against Latin-tagged languages", func() {
+			res := d.GetLanguages("hello")
+			So(len(res), ShouldEqual, 1)
+			So(res[0].Name, ShouldEqual, "english")
+		})
+	})
+
+	Convey("Subject: Test exclusive-script short-circuit", t, func() {
+		d := langdet.NewDetector()
+		d.AddLanguage(langdet.Language{Name: "korean", Scripts: []langdet.Script{langdet.ScriptHangul}})
+		d.AddLanguage(langdet.Language{Name: "english", Scripts: []langdet.Script{langdet.ScriptLatin}})
+
+		Convey("Hangul text should resolve directly to korean without running comparisons", func() {
+			res := d.GetLanguages("안녕하세요")
+			So(len(res), ShouldEqual, 1)
+			So(res[0].Name, ShouldEqual, "korean")
+			So(res[0].Confidence, ShouldEqual, 100)
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package langdet
+
+import (
+	"bytes"
+	"unicode"
+)
+
+// OccurrenceAccumulator incrementally builds the same map[token]occurrence that CreateOccurenceMap
+// produces from a complete text, but consumes input one rune at a time via Update. It backs
+// DetectReader and DetectReaderContext so they can score a stream without buffering it, and it
+// also tracks the Unicode script distribution of the runes seen so far, mirroring scriptCounts for
+// a complete text.
+type OccurrenceAccumulator struct {
+	gramDepth int
+	occ       map[string]int
+	scripts   map[Script]int
+	token     bytes.Buffer
+}
+
+// NewOccurrenceAccumulator returns an OccurrenceAccumulator that tokenizes at the given n-gram depth.
+func NewOccurrenceAccumulator(gramDepth int) *OccurrenceAccumulator {
+	return &OccurrenceAccumulator{
+		gramDepth: gramDepth,
+		occ:       make(map[string]int),
+		scripts:   make(map[Script]int),
+	}
+}
+
+// Update feeds a single rune into the accumulator. It mirrors cleanText's normalization rune by
+// rune: runes that cleanText turns into a separator flush the current token into the occurrence
+// map via analyseToken, and digits are dropped, same as in cleanText.
+func (a *OccurrenceAccumulator) Update(r rune) {
+	for script, table := range scriptRanges {
+		if unicode.Is(table, r) {
+			a.scripts[script]++
+		}
+	}
+	switch {
+	case isTokenBoundary(r):
+		a.flush()
+	case unicode.IsDigit(r):
+	default:
+		a.token.WriteRune(r)
+	}
+}
+
+func (a *OccurrenceAccumulator) flush() {
+	if a.token.Len() == 0 {
+		return
+	}
+	analyseToken(a.occ, a.token.String(), a.gramDepth)
+	a.token.Reset()
+}
+
+// Occurrences returns a snapshot of the occurrence map accumulated so far, flushing any token that
+// is still in progress first.
+func (a *OccurrenceAccumulator) Occurrences() map[string]int {
+	a.flush()
+	snapshot := make(map[string]int, len(a.occ))
+	for k, v := range a.occ {
+		snapshot[k] = v
+	}
+	return snapshot
+}
+
+// Scripts returns the dominant Script seen so far and the total number of script-tagged runes,
+// the same pair dominantScript(scriptCounts(text)) would return for a complete text.
+func (a *OccurrenceAccumulator) Scripts() (Script, int) {
+	return dominantScript(a.scripts)
+}
+
+// isTokenBoundary reports whether r is one of the separators cleanText replaces with a space.
+func isTokenBoundary(r rune) bool {
+	switch r {
+	case ' ', '\n', ',', '#', '/', '\\', '.', '!', '?', ':', ';', '-', '\'', '"', '_', '*':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package langdet_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chrisport/go-lang-detector/langdet"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOccurrenceAccumulator(t *testing.T) {
+	Convey("Subject: Test OccurrenceAccumulator", t, func() {
+		Convey("fed rune by rune, it should match CreateOccurenceMap over the same text", func() {
+			text := "TEXT"
+			acc := langdet.NewOccurrenceAccumulator(3)
+			for _, r := range text {
+				acc.Update(r)
+			}
+			expected := langdet.CreateOccurenceMap(text, 3)
+			So(acc.Occurrences(), ShouldResemble, expected)
+		})
+	})
+}
+
+func TestDetectReader(t *testing.T) {
+	Convey("Subject: Test DetectReader", t, func() {
+		s := "Hello I am english text, what is your language? I really dont know you say?"
+		d := langdet.NewDetector()
+		d.AddLanguageFromText(s, "english")
+		d.AddLanguageFromText("Je parles français et toi?", "french")
+
+		Convey("Should detect the closest language from a Reader the same way GetClosestLanguage does", func() {
+			res, err := d.DetectReader(strings.NewReader(s))
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "english")
+		})
+
+		Convey("Should return \"undefined\" if no language is confident enough", func() {
+			other := langdet.NewDetector()
+			other.AddLanguageFromText("Je parles français et toi?", "french")
+			res, err := other.DetectReader(strings.NewReader(s))
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, "undefined")
+		})
+	})
+}
@@ -0,0 +1,80 @@
+package langdet
+
+import (
+	"net/http"
+
+	"golang.org/x/text/language"
+)
+
+// defaultTags maps the name of each bundled default Language to its BCP 47 tag, so that
+// Detector.MatchTag and Detector.ParseAcceptLanguage work out of the box with DefaultDetector.
+// Custom languages added via AddLanguage/AddLanguageFromText do not get a tag unless one of the
+// *WithTag variants is used.
+var defaultTags = map[string]language.Tag{
+	"english": language.English,
+	"german":  language.German,
+	"french":  language.French,
+	"turkish": language.Turkish,
+	"arabic":  language.Arabic,
+	"hebrew":  language.Hebrew,
+	"russian": language.Russian,
+}
+
+// AddLanguageFromTextWithTag analyzes a text and creates a new Language with the given name,
+// the same way AddLanguageFromText does, and additionally tags it with the given BCP 47 tag so
+// it can be resolved by Detector.MatchTag and Detector.ParseAcceptLanguage.
+func (d *Detector) AddLanguageFromTextWithTag(textToAnalyze, languageName string, tag language.Tag) {
+	analyzedLanguage := Analyze(textToAnalyze, languageName)
+	analyzedLanguage.Tag = tag
+	d.AddLanguageComparators(&analyzedLanguage)
+}
+
+// MatchTag runs n-gram detection over text, maps the resulting candidates to their configured
+// BCP 47 tags and feeds them, ranked by confidence, into a language.NewMatcher together with the
+// given preferred tags. It returns the tag the matcher settled on and its matching confidence.
+//
+// Candidates without a configured Tag (the zero value language.Und) are ignored: they cannot be
+// usefully matched against preferred locales. If no candidate has a tag, MatchTag returns
+// (language.Und, language.No).
+func (d *Detector) MatchTag(text string, preferred ...language.Tag) (language.Tag, language.Confidence) {
+	languages := d.languagesSnapshot()
+	results := closestFromTable(languages, lazyLookupMap(text, nDepth), text, d.Scorer)
+
+	tagsByName := make(map[string]language.Tag, len(languages))
+	for _, l := range languages {
+		if tag := l.GetTag(); tag != language.Und {
+			tagsByName[l.GetName()] = tag
+		}
+	}
+
+	var candidates []language.Tag
+	for _, res := range results {
+		if tag, ok := tagsByName[res.Name]; ok {
+			candidates = append(candidates, tag)
+		}
+	}
+	if len(candidates) == 0 {
+		return language.Und, language.No
+	}
+
+	matcher := language.NewMatcher(candidates)
+	tag, _, confidence := matcher.Match(preferred...)
+	return tag, confidence
+}
+
+// ParseAcceptLanguage detects the language of text and matches it against the locales listed in
+// an HTTP Accept-Language header, which is the typical i18n use case: pick the best UI locale for
+// a piece of detected text given what the client says it accepts.
+func (d *Detector) ParseAcceptLanguage(text, acceptHeader string) (language.Tag, language.Confidence) {
+	preferred, _, err := language.ParseAcceptLanguage(acceptHeader)
+	if err != nil {
+		preferred = nil
+	}
+	return d.MatchTag(text, preferred...)
+}
+
+// ParseAcceptLanguageFromRequest is a convenience wrapper around ParseAcceptLanguage that reads
+// the Accept-Language header directly off an *http.Request.
+func (d *Detector) ParseAcceptLanguageFromRequest(text string, r *http.Request) (language.Tag, language.Confidence) {
+	return d.ParseAcceptLanguage(text, r.Header.Get("Accept-Language"))
+}
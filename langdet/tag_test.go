@@ -0,0 +1,51 @@
+package langdet_test
+
+import (
+	"github.com/chrisport/go-lang-detector/langdet"
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/text/language"
+	"testing"
+)
+
+func TestMatchTag(t *testing.T) {
+	Convey("Subject: Test MatchTag", t, func() {
+		s := "Hello I am english text, what is your language? I really dont know you say?"
+		d := langdet.NewDetector()
+		d.AddLanguageFromTextWithTag(s, "english", language.English)
+		d.AddLanguageFromTextWithTag("Je parles français et toi?", "french", language.French)
+
+		Convey("When no preferred tag is given, it should match the detected language", func() {
+			tag, _ := d.MatchTag(s)
+			So(tag, ShouldEqual, language.English)
+		})
+
+		Convey("When a preferred regional variant is given, it should resolve to the closest configured tag", func() {
+			tag, confidence := d.MatchTag(s, language.BritishEnglish)
+			base, _ := tag.Base()
+			So(base.String(), ShouldEqual, "en")
+			So(confidence, ShouldBeGreaterThan, language.No)
+		})
+
+		Convey("When no candidate has a tag, it should return Und and No confidence", func() {
+			untagged := langdet.NewDetector()
+			untagged.AddLanguageFromText(s, "english")
+			tag, confidence := untagged.MatchTag(s)
+			So(tag, ShouldEqual, language.Und)
+			So(confidence, ShouldEqual, language.No)
+		})
+	})
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	Convey("Subject: Test ParseAcceptLanguage", t, func() {
+		s := "Hello I am english text, what is your language? I really dont know you say?"
+		d := langdet.NewDetector()
+		d.AddLanguageFromTextWithTag(s, "english", language.English)
+		d.AddLanguageFromTextWithTag("Je parles français et toi?", "french", language.French)
+
+		Convey("It should prefer the accepted locale with the highest q-value among supported candidates", func() {
+			tag, _ := d.ParseAcceptLanguage(s, "fr;q=0.9, en;q=0.8")
+			So(tag, ShouldEqual, language.French)
+		})
+	})
+}